@@ -0,0 +1,248 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader — заголовок, по которому сервер связывает запрос с записями в логах.
+	RequestIDHeader = "X-Request-Id"
+	// UserIDHeader — заголовок с пользователем, от имени которого выполняется запрос.
+	UserIDHeader = "X-User-Id"
+)
+
+// API — тонкая обертка над сгенерированным ClientWithResponses. Методы возвращают модели,
+// а не сырые ответы, переводят ошибки сервера в *APIError (сравнимые с Err* через errors.Is),
+// повторяют идемпотентные запросы на 5xx и проставляют X-Request-Id.
+type API struct {
+	raw *ClientWithResponses
+}
+
+type config struct {
+	doer        HttpRequestDoer
+	userID      string
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	editors     []RequestEditorFn
+}
+
+// Option настраивает API.
+type Option func(*config)
+
+// WithDoer задает HTTP-клиент. По умолчанию http.Client с таймаутом 10 секунд.
+func WithDoer(doer HttpRequestDoer) Option {
+	return func(c *config) { c.doer = doer }
+}
+
+// WithUserID проставляет X-User-Id во все запросы.
+func WithUserID(userID string) Option {
+	return func(c *config) { c.userID = userID }
+}
+
+// WithRetry задает число попыток (включая первую) и границы задержки между ними.
+// Повторяются только GET и вызовы, помеченные WithIdempotent. maxAttempts = 1 отключает повторы.
+func WithRetry(maxAttempts int, baseDelay, maxDelay time.Duration) Option {
+	return func(c *config) {
+		c.maxAttempts = maxAttempts
+		c.baseDelay = baseDelay
+		c.maxDelay = maxDelay
+	}
+}
+
+// WithRequestEditor добавляет функцию, которая меняет каждый запрос перед отправкой
+// (например, проставляет заголовок авторизации шлюза).
+func WithRequestEditor(fn RequestEditorFn) Option {
+	return func(c *config) { c.editors = append(c.editors, fn) }
+}
+
+// New создает клиент к сервису по адресу baseURL, например http://localhost:8080.
+func New(baseURL string, opts ...Option) (*API, error) {
+	cfg := config{
+		doer:        &http.Client{Timeout: 10 * time.Second},
+		maxAttempts: 3,
+		baseDelay:   100 * time.Millisecond,
+		maxDelay:    2 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxAttempts < 1 || cfg.baseDelay <= 0 || cfg.maxDelay <= 0 {
+		return nil, errors.New("client: retry attempts and delays must be positive")
+	}
+
+	clientOpts := []ClientOption{
+		WithHTTPClient(&retryDoer{
+			next:        cfg.doer,
+			maxAttempts: cfg.maxAttempts,
+			baseDelay:   cfg.baseDelay,
+			maxDelay:    cfg.maxDelay,
+		}),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			requestID, ok := RequestIDFromContext(ctx)
+			if !ok {
+				requestID = uuid.NewString()
+			}
+			req.Header.Set(RequestIDHeader, requestID)
+			if cfg.userID != "" {
+				req.Header.Set(UserIDHeader, cfg.userID)
+			}
+			return nil
+		}),
+	}
+	for _, fn := range cfg.editors {
+		clientOpts = append(clientOpts, WithRequestEditorFn(fn))
+	}
+
+	raw, err := NewClientWithResponses(baseURL, clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	return &API{raw: raw}, nil
+}
+
+// Raw возвращает сгенерированный клиент для случаев, когда нужен полный HTTP-ответ.
+func (a *API) Raw() *ClientWithResponses {
+	return a.raw
+}
+
+type requestIDKey struct{}
+
+// WithRequestID кладет в контекст request ID для заголовка X-Request-Id: так ID входящего
+// запроса вызывающего сервиса попадает в логи этого. Без него на каждый вызов генерируется
+// новый ID, одинаковый для всех повторов.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext возвращает request ID, положенный через WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// CreateTeam создает команду с участниками.
+func (a *API) CreateTeam(ctx context.Context, team Team) (*Team, error) {
+	const op = "client.CreateTeam"
+
+	resp, err := a.raw.PostTeamAddWithResponse(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON201 == nil || resp.JSON201.Team == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON201.Team, nil
+}
+
+// GetTeam возвращает команду с участниками.
+func (a *API) GetTeam(ctx context.Context, teamName string) (*Team, error) {
+	const op = "client.GetTeam"
+
+	resp, err := a.raw.GetTeamGetWithResponse(ctx, &GetTeamGetParams{TeamName: teamName})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON200, nil
+}
+
+// SetUserIsActive меняет флаг активности пользователя.
+func (a *API) SetUserIsActive(ctx context.Context, userID string, isActive bool) (*User, error) {
+	const op = "client.SetUserIsActive"
+
+	resp, err := a.raw.PostUsersSetIsActiveWithResponse(WithIdempotent(ctx), PostUsersSetIsActiveJSONRequestBody{
+		UserId:   userID,
+		IsActive: isActive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.User == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON200.User, nil
+}
+
+// GetReviews возвращает PR'ы, где пользователь назначен ревьювером.
+func (a *API) GetReviews(ctx context.Context, userID string) ([]PullRequestShort, error) {
+	const op = "client.GetReviews"
+
+	resp, err := a.raw.GetUsersGetReviewWithResponse(ctx, &GetUsersGetReviewParams{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON200.PullRequests, nil
+}
+
+// CreatePullRequest создает PR; сервер сам назначает до двух ревьюверов из команды автора.
+// Если автор состоит в нескольких командах, сервер отвечает ErrTeamRequired: тогда нужен
+// CreatePullRequestInTeam.
+func (a *API) CreatePullRequest(ctx context.Context, pullRequestID, name, authorID string) (*PullRequest, error) {
+	return a.CreatePullRequestInTeam(ctx, pullRequestID, name, authorID, "")
+}
+
+// CreatePullRequestInTeam создает PR в команде teamName, в которой состоит автор.
+// Пустой teamName означает единственную команду автора.
+func (a *API) CreatePullRequestInTeam(ctx context.Context, pullRequestID, name, authorID, teamName string) (*PullRequest, error) {
+	const op = "client.CreatePullRequest"
+
+	resp, err := a.raw.PostPullRequestCreateWithResponse(ctx, PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequestID,
+		PullRequestName: name,
+		AuthorId:        authorID,
+		TeamName:        teamName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON201 == nil || resp.JSON201.Pr == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON201.Pr, nil
+}
+
+// MergePullRequest помечает PR как MERGED. Повторный вызов для уже слитого PR не ошибка.
+func (a *API) MergePullRequest(ctx context.Context, pullRequestID string) (*PullRequest, error) {
+	const op = "client.MergePullRequest"
+
+	resp, err := a.raw.PostPullRequestMergeWithResponse(WithIdempotent(ctx), PostPullRequestMergeJSONRequestBody{
+		PullRequestId: pullRequestID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Pr == nil {
+		return nil, fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return resp.JSON200.Pr, nil
+}
+
+// ReassignReviewer заменяет ревьювера oldUserID другим участником его команды и возвращает
+// обновленный PR вместе с user_id нового ревьювера.
+func (a *API) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (*PullRequest, string, error) {
+	const op = "client.ReassignReviewer"
+
+	resp, err := a.raw.PostPullRequestReassignWithResponse(ctx, PostPullRequestReassignJSONRequestBody{
+		PullRequestId: pullRequestID,
+		OldUserId:     oldUserID,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if resp.JSON200 == nil {
+		return nil, "", fmt.Errorf("%s: %w", op, responseError(resp.HTTPResponse, resp.Body))
+	}
+	return &resp.JSON200.Pr, resp.JSON200.ReplacedBy, nil
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Ошибки, в которые переводятся коды ErrorResponseErrorCode. Проверяются через errors.Is:
+//
+//	if errors.Is(err, client.ErrPRMerged) { ... }
+var (
+	ErrTeamExists   = errors.New("team already exists")
+	ErrPRExists     = errors.New("pr already exists")
+	ErrPRMerged     = errors.New("pr is already merged")
+	ErrPRClosed     = errors.New("pr is closed")
+	ErrNotAssigned  = errors.New("reviewer is not assigned to this pr")
+	ErrNoCandidate  = errors.New("no active replacement candidate in team")
+	ErrNotFound     = errors.New("resource not found")
+	ErrUnauthorized = errors.New("unauthorized")
+
+	ErrTeamHasOpenReviews = errors.New("team pull requests still have open reviews")
+	ErrUserInOtherTeam    = errors.New("user already belongs to another team")
+	ErrInvalidInput       = errors.New("invalid input")
+	ErrTeamRequired       = errors.New("author belongs to several teams, team_name is required")
+	ErrUnknownIdentity    = errors.New("login is not linked to a user")
+
+	// ErrServer возвращается, если сервер ответил 5xx и попытки повтора закончились.
+	ErrServer = errors.New("server error")
+)
+
+var codeErrors = map[ErrorResponseErrorCode]error{
+	TEAMEXISTS:         ErrTeamExists,
+	PREXISTS:           ErrPRExists,
+	PRMERGED:           ErrPRMerged,
+	PRCLOSED:           ErrPRClosed,
+	NOTASSIGNED:        ErrNotAssigned,
+	NOCANDIDATE:        ErrNoCandidate,
+	NOTFOUND:           ErrNotFound,
+	UNAUTHORIZED:       ErrUnauthorized,
+	TEAMHASOPENREVIEWS: ErrTeamHasOpenReviews,
+	USERINOTHERTEAM:    ErrUserInOtherTeam,
+	INVALIDINPUT:       ErrInvalidInput,
+	TEAMREQUIRED:       ErrTeamRequired,
+	UNKNOWNIDENTITY:    ErrUnknownIdentity,
+}
+
+// APIError описывает неуспешный ответ сервера. Code пуст, если сервер ответил без доменного
+// кода (например, {"error": "..."} на невалидный JSON или 5xx).
+type APIError struct {
+	StatusCode int
+	Code       ErrorResponseErrorCode
+	Message    string
+	// RequestID из ответа сервера, по нему ищется запрос в логах.
+	RequestID string
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("api error %d %s: %s (request_id=%s)", e.StatusCode, e.Code, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("api error %d: %s (request_id=%s)", e.StatusCode, e.Message, e.RequestID)
+}
+
+// Unwrap дает errors.Is сравнивать APIError с Err* по коду, а без кода — по HTTP-статусу.
+func (e *APIError) Unwrap() error {
+	if err, ok := codeErrors[e.Code]; ok {
+		return err
+	}
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusBadRequest:
+		return ErrInvalidInput
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrServer
+	}
+	return nil
+}
+
+// plainErrorResponse — формат utils.WriteError: {"error": "message"}.
+type plainErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// responseError собирает APIError из тела неуспешного ответа. Понимает оба формата ошибок
+// сервера: ErrorResponse с кодом и простой {"error": "..."}.
+func responseError(resp *http.Response, body []byte) error {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get(RequestIDHeader),
+	}
+
+	var coded ErrorResponse
+	var plain plainErrorResponse
+	switch {
+	case json.Unmarshal(body, &coded) == nil && coded.Error.Code != "":
+		apiErr.Code = coded.Error.Code
+		apiErr.Message = coded.Error.Message
+	case json.Unmarshal(body, &plain) == nil && plain.Error != "":
+		apiErr.Message = plain.Error
+	default:
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// retryDoer повторяет идемпотентные запросы с экспоненциальной задержкой и джиттером после
+// сетевой ошибки или ответа 5xx. POST мог дойти до сервера и примениться, поэтому повторяется,
+// только если вызов помечен через WithIdempotent. Ожидание прерывается отменой контекста запроса.
+type retryDoer struct {
+	next        HttpRequestDoer
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := d.next.Do(req)
+		if attempt >= d.maxAttempts || !retryable(req, resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(d.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// backoff возвращает случайную задержку в [0, min(baseDelay*2^(attempt-1), maxDelay)).
+func (d *retryDoer) backoff(attempt int) time.Duration {
+	delay := d.baseDelay << (attempt - 1)
+	if delay <= 0 || delay > d.maxDelay {
+		delay = d.maxDelay
+	}
+	return rand.N(delay)
+}
+
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if !idempotent(req) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented
+}
+
+// idempotent сообщает, можно ли безопасно отправить запрос повторно.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	marked, _ := req.Context().Value(idempotentKey{}).(bool)
+	return marked
+}
+
+type idempotentKey struct{}
+
+// WithIdempotent помечает вызов как идемпотентный: его POST-запросы тоже повторяются после
+// сетевой ошибки или ответа 5xx. Подходит для операций, повтор которых не меняет результат,
+// например /pullRequest/merge или /users/setIsActive.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// rewind готовит копию запроса для следующей попытки с заново открытым телом.
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
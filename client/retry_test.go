@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// flakyServer отвечает 503 на первые failures запросов, затем 200, и считает запросы.
+func flakyServer(t *testing.T, failures int64) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func newTestRetryDoer() *retryDoer {
+	return &retryDoer{
+		next:        http.DefaultClient,
+		maxAttempts: 3,
+		baseDelay:   time.Millisecond,
+		maxDelay:    time.Millisecond,
+	}
+}
+
+func TestRetryDoerRetriesOnlyIdempotentRequests(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		idempotent bool
+		wantCalls  int64
+		wantStatus int
+	}{
+		{name: "get", method: http.MethodGet, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "post", method: http.MethodPost, wantCalls: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "post marked idempotent", method: http.MethodPost, idempotent: true, wantCalls: 3, wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := flakyServer(t, 2)
+
+			ctx := context.Background()
+			if tt.idempotent {
+				ctx = WithIdempotent(ctx)
+			}
+			req, err := http.NewRequestWithContext(ctx, tt.method, server.URL, strings.NewReader(`{"pull_request_id":"pr-1"}`))
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+
+			resp, err := newTestRetryDoer().Do(req)
+			if err != nil {
+				t.Fatalf("do: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("server got %d requests, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCreatePullRequestIsNotRetried(t *testing.T) {
+	server, calls := flakyServer(t, 1)
+	api, err := New(server.URL, WithRetry(3, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = api.CreatePullRequest(context.Background(), "pr-1", "Add search", "u1")
+	if !errors.Is(err, ErrServer) {
+		t.Errorf("create: got %v, want ErrServer", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server got %d requests, want 1", got)
+	}
+}
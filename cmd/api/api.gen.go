@@ -4,36 +4,147 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for DomainEventType.
+const (
+	DomainEventPRCLOSED           DomainEventType = "pr.closed"
+	DomainEventPRCREATED          DomainEventType = "pr.created"
+	DomainEventPRMERGED           DomainEventType = "pr.merged"
+	DomainEventPRREOPENED         DomainEventType = "pr.reopened"
+	DomainEventREVIEWERASSIGNED   DomainEventType = "reviewer.assigned"
+	DomainEventREVIEWERREASSIGNED DomainEventType = "reviewer.reassigned"
+	DomainEventREVIEWERUNASSIGNED DomainEventType = "reviewer.unassigned"
+	DomainEventTEAMCREATED        DomainEventType = "team.created"
+	DomainEventTEAMDELETED        DomainEventType = "team.deleted"
+	DomainEventTEAMMEMBERADDED    DomainEventType = "team.member_added"
+	DomainEventTEAMMEMBERREMOVED  DomainEventType = "team.member_removed"
+	DomainEventTEAMRENAMED        DomainEventType = "team.renamed"
+	DomainEventTEAMUPDATED        DomainEventType = "team.updated"
+	DomainEventUSERACTIVATED      DomainEventType = "user.activated"
+	DomainEventUSERCREATED        DomainEventType = "user.created"
+	DomainEventUSERDEACTIVATED    DomainEventType = "user.deactivated"
+	DomainEventUSERMOVED          DomainEventType = "user.moved"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	INVALIDINPUT       ErrorResponseErrorCode = "INVALID_INPUT"
+	NOCANDIDATE        ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED        ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND           ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED           ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS           ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED           ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS         ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHASOPENREVIEWS ErrorResponseErrorCode = "TEAM_HAS_OPEN_REVIEWS"
+	TEAMREQUIRED       ErrorResponseErrorCode = "TEAM_REQUIRED"
+	UNAUTHORIZED       ErrorResponseErrorCode = "UNAUTHORIZED"
+	UNKNOWNIDENTITY    ErrorResponseErrorCode = "UNKNOWN_IDENTITY"
+	USERINOTHERTEAM    ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+)
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
-// Defines values for PullRequestShortStatus.
+// Defines values for ReviewStrategy.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	ReviewStrategyLEASTLOADED ReviewStrategy = "least_loaded"
+	ReviewStrategyRANDOM      ReviewStrategy = "random"
+)
+
+// Defines values for ReviewStreamEventType.
+const (
+	ReviewStreamASSIGNED      ReviewStreamEventType = "review.assigned"
+	ReviewStreamRESYNC        ReviewStreamEventType = "resync"
+	ReviewStreamSTATUSCHANGED ReviewStreamEventType = "review.status_changed"
+	ReviewStreamUNASSIGNED    ReviewStreamEventType = "review.unassigned"
+)
+
+// Defines values for UserSearchMode.
+const (
+	UserSearchModePREFIX  UserSearchMode = "prefix"
+	UserSearchModeTRIGRAM UserSearchMode = "trigram"
+)
+
+// Defines values for GetStatsTeamsParamsFormat.
+const (
+	GetStatsTeamsParamsFormatCsv  GetStatsTeamsParamsFormat = "csv"
+	GetStatsTeamsParamsFormatJson GetStatsTeamsParamsFormat = "json"
+)
+
+// Defines values for GetTeamExportParamsFormat.
+const (
+	GetTeamExportParamsFormatCsv GetTeamExportParamsFormat = "csv"
 )
 
+// DeadLetter Доставка вебхука, от которой отказались после исчерпания попыток
+type DeadLetter struct {
+	Attempts int    `json:"attempts"`
+	EventId  string `json:"event_id"`
+
+	// EventType Тип доменного события
+	EventType DomainEventType `json:"event_type"`
+	FailedAt  time.Time       `json:"failed_at"`
+	Id        int64           `json:"id"`
+	LastError string          `json:"last_error"`
+
+	// Payload Тело доставки — DomainEvent в JSON
+	Payload        json.RawMessage `json:"payload"`
+	SubscriptionId string          `json:"subscription_id"`
+}
+
+// DomainEvent Доменное событие в том виде, в котором оно уходит подписчикам вебхуков и в брокер.
+// pull_request заполнен для pr.created и pr.merged; reviewer_id — для событий ревьюверов,
+// old_reviewer_id — для reviewer.reassigned (reviewer_id пуст, если замены не нашлось).
+// user_id — участник в событиях команд и пользователей; previous_team_name — прежнее имя
+// команды для team.renamed и прежняя команда для user.moved
+type DomainEvent struct {
+	Id               string       `json:"id"`
+	OccurredAt       time.Time    `json:"occurred_at"`
+	OldReviewerId    string       `json:"old_reviewer_id,omitempty"`
+	PreviousTeamName string       `json:"previous_team_name,omitempty"`
+	PullRequest      *PullRequest `json:"pull_request,omitempty"`
+	PullRequestId    string       `json:"pull_request_id,omitempty"`
+	ReviewerId       string       `json:"reviewer_id,omitempty"`
+	TeamName         string       `json:"team_name,omitempty"`
+
+	// Type Тип доменного события
+	Type   DomainEventType `json:"type"`
+	UserId string          `json:"user_id,omitempty"`
+}
+
+// DomainEventType Тип доменного события
+type DomainEventType string
+
+// EffectiveTeamSettings Настройки команды после применения наследования. sla_hours равен 0, если SLA не задан ни на одном уровне дерева
+type EffectiveTeamSettings struct {
+	ReviewerCount int `json:"reviewer_count"`
+	SlaHours      int `json:"sla_hours"`
+
+	// Strategy Способ выбора ревьюверов из команды
+	Strategy ReviewStrategy `json:"strategy"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -45,21 +156,61 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// FairnessOutlier defines model for FairnessOutlier.
+type FairnessOutlier struct {
+	Assignments int     `json:"assignments"`
+	UserId      string  `json:"user_id"`
+	Username    string  `json:"username"`
+	ZScore      float64 `json:"z_score"`
+}
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	From       *time.Time     `json:"from"`
+	TeamName   *string        `json:"team_name"`
+	Teams      []TeamFairness `json:"teams"`
+	To         *time.Time     `json:"to"`
+	ZThreshold float64        `json:"z_threshold"`
+}
+
+// GitIdentity Логин пользователя в системе хостинга кода или чате
+type GitIdentity struct {
+	Login string `json:"login"`
+
+	// Provider github, gitlab, slack или mattermost
+	Provider string `json:"provider"`
+	UserId   string `json:"user_id"`
+}
+
+// NotificationSettings Настройки email-уведомлений. Пустой email отключает письма
+type NotificationSettings struct {
+	Assigned   bool   `json:"assigned"`
+	Digest     bool   `json:"digest"`
+	Email      string `json:"email"`
+	Reassigned bool   `json:"reassigned"`
+	UserId     string `json:"user_id"`
+}
+
+// NotificationSettingsResponse defines model for NotificationSettingsResponse.
+type NotificationSettingsResponse struct {
+	// Settings Настройки email-уведомлений. Пустой email отключает письма
+	Settings NotificationSettings `json:"settings"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Status CLOSED — PR закрыт без merge на стороне GitHub/GitLab
+	Status PullRequestStatus `json:"status"`
 }
 
-// PullRequestStatus defines model for PullRequest.Status.
-type PullRequestStatus string
-
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -71,12 +222,89 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// PullRequestStatus CLOSED — PR закрыт без merge на стороне GitHub/GitLab
+type PullRequestStatus string
+
+// ReviewStrategy Способ выбора ревьюверов из команды
+type ReviewStrategy string
+
+// ReviewStreamEvent Изменение очереди ревью пользователя. id совпадает с ID доменного события, status заполнен для review.status_changed
+type ReviewStreamEvent struct {
+	Id            string            `json:"id,omitempty"`
+	OccurredAt    time.Time         `json:"occurred_at"`
+	PullRequestId string            `json:"pull_request_id,omitempty"`
+	Status        PullRequestStatus `json:"status,omitempty"`
+
+	// Type Вид события в потоке /users/reviewStream. resync означает, что часть событий могла
+	// потеряться (например, при переподключении к БД), и очередь стоит перечитать через /users/getReview
+	Type   ReviewStreamEventType `json:"type"`
+	UserId string                `json:"user_id"`
+}
+
+// ReviewStreamEventType Вид события в потоке /users/reviewStream. resync означает, что часть событий могла
+// потеряться (например, при переподключении к БД), и очередь стоит перечитать через /users/getReview
+type ReviewStreamEventType string
+
+// ReviewerStats Статистика ревьювера за окно. avg_hours_to_merge равен null, если merged PR в окне нет
+type ReviewerStats struct {
+	AvgHoursToMerge  *float64 `json:"avg_hours_to_merge"`
+	IsActive         bool     `json:"is_active"`
+	MergedReviews    int      `json:"merged_reviews"`
+	OpenReviews      int      `json:"open_reviews"`
+	ReassignedAway   int      `json:"reassigned_away"`
+	TeamName         string   `json:"team_name"`
+	TotalAssignments int      `json:"total_assignments"`
+	UserId           string   `json:"user_id"`
+	Username         string   `json:"username"`
+}
+
+// ReviewerStatsReport defines model for ReviewerStatsReport.
+type ReviewerStatsReport struct {
+	From      *time.Time      `json:"from"`
+	Reviewers []ReviewerStats `json:"reviewers"`
+	TeamName  *string         `json:"team_name"`
+	To        *time.Time      `json:"to"`
+}
+
+// RosterRowError Ошибка валидации строки импортируемого файла. line равен 0 для ошибок файла целиком
+type RosterRowError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+	UserId  string `json:"user_id,omitempty"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamFairness Распределение числа назначений на ревью по активным участникам команды. strategy — all для
+// всех назначений, иначе стратегия, с которой они сделаны. gini равен null, если назначений
+// не было; max_min_ratio — если у кого-то из участников их нет
+type TeamFairness struct {
+	Gini             *float64          `json:"gini"`
+	MaxAssignments   int               `json:"max_assignments"`
+	MaxMinRatio      *float64          `json:"max_min_ratio"`
+	MeanAssignments  float64           `json:"mean_assignments"`
+	Members          int               `json:"members"`
+	MinAssignments   int               `json:"min_assignments"`
+	Outliers         []FairnessOutlier `json:"outliers"`
+	StdDev           float64           `json:"std_dev"`
+	Strategy         string            `json:"strategy"`
+	TeamName         string            `json:"team_name"`
+	TotalAssignments int               `json:"total_assignments"`
+}
+
+// TeamImportResult Отчет /team/import. Файл применяется целиком, только если в нем нет ошибок
+type TeamImportResult struct {
+	Applied bool             `json:"applied"`
+	DryRun  bool             `json:"dry_run"`
+	Errors  []RosterRowError `json:"errors"`
+	Rows    int              `json:"rows"`
+	Teams   int              `json:"teams"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -84,6 +312,75 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamMembership defines model for TeamMembership.
+type TeamMembership struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// TeamNode Команда вместе с положением в иерархии, настройками и поддеревом
+type TeamNode struct {
+	// EffectiveSettings Настройки команды после применения наследования. sla_hours равен 0, если SLA не задан ни на одном уровне дерева
+	EffectiveSettings EffectiveTeamSettings `json:"effective_settings"`
+	Members           []TeamMember          `json:"members"`
+	ParentTeam        *string               `json:"parent_team"`
+
+	// Settings Собственные настройки команды. Незаданные поля наследуются от родительской команды
+	Settings TeamSettings `json:"settings"`
+	Subteams []TeamNode   `json:"subteams,omitempty"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamNodeResponse defines model for TeamNodeResponse.
+type TeamNodeResponse struct {
+	// Team Команда вместе с положением в иерархии, настройками и поддеревом
+	Team TeamNode `json:"team"`
+}
+
+// TeamResponse defines model for TeamResponse.
+type TeamResponse struct {
+	Team Team `json:"team"`
+}
+
+// TeamSettings Собственные настройки команды. Незаданные поля наследуются от родительской команды
+type TeamSettings struct {
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+	SlaHours      *int `json:"sla_hours,omitempty"`
+
+	// Strategy Способ выбора ревьюверов из команды
+	Strategy *ReviewStrategy `json:"strategy,omitempty"`
+}
+
+// TeamStats Пропускная способность и время цикла команды за окно. Процентили времени до merge считаются
+// по методу ближайшего ранга и равны null, если merged PR нет
+type TeamStats struct {
+	MedianHoursToMerge *float64 `json:"median_hours_to_merge"`
+	NoCandidateEvents  int      `json:"no_candidate_events"`
+	P90HoursToMerge    *float64 `json:"p90_hours_to_merge"`
+	PrsCreated         int      `json:"prs_created"`
+	PrsMerged          int      `json:"prs_merged"`
+
+	// ShareUnderTwoReviewers Доля PR, созданных менее чем с двумя ревьюверами
+	ShareUnderTwoReviewers *float64        `json:"share_under_two_reviewers"`
+	TeamName               string          `json:"team_name"`
+	Weeks                  []TeamWeekStats `json:"weeks"`
+}
+
+// TeamStatsReport defines model for TeamStatsReport.
+type TeamStatsReport struct {
+	From     *time.Time  `json:"from"`
+	TeamName *string     `json:"team_name"`
+	Teams    []TeamStats `json:"teams"`
+	To       *time.Time  `json:"to"`
+}
+
+// TeamWeekStats Число созданных и смерженных PR команды за неделю (неделя начинается в понедельник, UTC)
+type TeamWeekStats struct {
+	PrsCreated int       `json:"prs_created"`
+	PrsMerged  int       `json:"prs_merged"`
+	WeekStart  time.Time `json:"week_start"`
+}
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -92,9 +389,64 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserMove defines model for UserMove.
+type UserMove struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// UserPage Страница /users/list. next_cursor равен null на последней странице
+type UserPage struct {
+	NextCursor *string       `json:"next_cursor"`
+	Users      []UserProfile `json:"users"`
+}
+
+// UserProfile Карточка пользователя для /users/get и /users/list
+type UserProfile struct {
+	IsActive bool   `json:"is_active"`
+	Role     string `json:"role"`
+
+	// TeamName Основная команда
+	TeamName string   `json:"team_name"`
+	Teams    []string `json:"teams"`
+	UserId   string   `json:"user_id"`
+	Username string   `json:"username"`
+}
+
+// UserSearchMode Способ поиска по username
+type UserSearchMode string
+
+// WebhookResult Ответ на доставку вебхука. result описывает, что сделано с PR (created, created_draft, draft,
+// ready, merged, closed, reopened, duplicate), или ignored, если событие не меняет жизненный цикл PR
+type WebhookResult struct {
+	Action        string `json:"action,omitempty"`
+	Event         string `json:"event"`
+	PullRequestId string `json:"pull_request_id,omitempty"`
+	Result        string `json:"result"`
+}
+
+// WebhookSubscription Подписка команды на исходящие вебхуки. secret принимается при создании и не возвращается в ответах
+type WebhookSubscription struct {
+	CreatedAt      *time.Time        `json:"created_at,omitempty"`
+	EventTypes     []DomainEventType `json:"event_types"`
+	Secret         string            `json:"secret,omitempty"`
+	SubscriptionId string            `json:"subscription_id"`
+	TeamName       string            `json:"team_name"`
+	Url            string            `json:"url"`
+}
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// TeamNameFilterQuery defines model for TeamNameFilterQuery.
+type TeamNameFilterQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
@@ -103,6 +455,9 @@ type PostPullRequestCreateJSONBody struct {
 	AuthorId        string `json:"author_id"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// TeamName Команда PR. Обязательна, если автор состоит в нескольких командах
+	TeamName string `json:"team_name,omitempty"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -116,10 +471,146 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// TeamName Ограничить отчет одной командой
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна, RFC 3339 или YYYY-MM-DD (начало дня по UTC)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включая), RFC 3339 или YYYY-MM-DD
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// ZThreshold Порог |z-score| для выбросов (по умолчанию 1.5)
+	ZThreshold float64 `form:"z_threshold,omitempty" json:"z_threshold,omitempty"`
+}
+
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	// TeamName Ограничить отчет одной командой
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна, RFC 3339 или YYYY-MM-DD (начало дня по UTC)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включая), RFC 3339 или YYYY-MM-DD
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// TeamName Ограничить отчет одной командой
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна, RFC 3339 или YYYY-MM-DD (начало дня по UTC)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включая), RFC 3339 или YYYY-MM-DD
+	To     *ToQuery                   `form:"to,omitempty" json:"to,omitempty"`
+	Format *GetStatsTeamsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetStatsTeamsParamsFormat defines parameters for GetStatsTeams.
+type GetStatsTeamsParamsFormat string
+
+// PostSubscriptionsAddJSONBody defines parameters for PostSubscriptionsAdd.
+type PostSubscriptionsAddJSONBody struct {
+	EventTypes []DomainEventType `json:"event_types"`
+
+	// Secret Ключ HMAC-подписи доставок; в ответах не возвращается
+	Secret   string `json:"secret"`
+	TeamName string `json:"team_name"`
+	Url      string `json:"url"`
+}
+
+// GetSubscriptionsDeadLettersParams defines parameters for GetSubscriptionsDeadLetters.
+type GetSubscriptionsDeadLettersParams struct {
+	SubscriptionId string `form:"subscription_id" json:"subscription_id"`
+}
+
+// PostSubscriptionsDeleteJSONBody defines parameters for PostSubscriptionsDelete.
+type PostSubscriptionsDeleteJSONBody struct {
+	SubscriptionId string `json:"subscription_id"`
+}
+
+// GetSubscriptionsListParams defines parameters for GetSubscriptionsList.
+type GetSubscriptionsListParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostSubscriptionsRedeliverJSONBody defines parameters for PostSubscriptionsRedeliver.
+type PostSubscriptionsRedeliverJSONBody struct {
+	DeadLetterId int64 `json:"dead_letter_id"`
+}
+
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// GetTeamExportParams defines parameters for GetTeamExport.
+type GetTeamExportParams struct {
+	Format *GetTeamExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTeamExportParamsFormat defines parameters for GetTeamExport.
+type GetTeamExportParamsFormat string
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// IncludeSubtree Вернуть TeamNode с поддеревом вместо Team. Используйте /team/tree
+	IncludeSubtree *bool `form:"include_subtree,omitempty" json:"include_subtree,omitempty"`
+}
+
+// PostTeamImportMultipartBody defines parameters for PostTeamImport.
+type PostTeamImportMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostTeamImportParams defines parameters for PostTeamImport.
+type PostTeamImportParams struct {
+	// DryRun Только проверить файл
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamSetParentJSONBody defines parameters for PostTeamSetParent.
+type PostTeamSetParentJSONBody struct {
+	// ParentTeam null делает команду корнем дерева
+	ParentTeam *string `json:"parent_team"`
+	TeamName   string  `json:"team_name"`
+}
+
+// PostTeamSettingsJSONBody defines parameters for PostTeamSettings.
+type PostTeamSettingsJSONBody struct {
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+	SlaHours      *int `json:"sla_hours,omitempty"`
+
+	// Strategy Способ выбора ревьюверов из команды
+	Strategy *ReviewStrategy `json:"strategy,omitempty"`
+	TeamName string          `json:"team_name"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetUsersGetParams defines parameters for GetUsersGet.
+type GetUsersGetParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
@@ -128,112 +619,1074 @@ type GetUsersGetReviewParams struct {
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersIdentitiesParams defines parameters for GetUsersIdentities.
+type GetUsersIdentitiesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersListParams defines parameters for GetUsersList.
+type GetUsersListParams struct {
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IsActive *bool   `form:"is_active,omitempty" json:"is_active,omitempty"`
+	Role     *string `form:"role,omitempty" json:"role,omitempty"`
+
+	// Query Строка поиска по username
+	Query string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Search Способ поиска по q (по умолчанию prefix)
+	Search UserSearchMode `form:"search,omitempty" json:"search,omitempty"`
+
+	// Cursor next_cursor из предыдущей страницы
+	Cursor string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersNotificationSettingsParams defines parameters for GetUsersNotificationSettings.
+type GetUsersNotificationSettingsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersNotificationSettingsJSONBody defines parameters for PostUsersNotificationSettings.
+type PostUsersNotificationSettingsJSONBody struct {
+	Assigned   *bool   `json:"assigned,omitempty"`
+	Digest     *bool   `json:"digest,omitempty"`
+	Email      *string `json:"email,omitempty"`
+	Reassigned *bool   `json:"reassigned,omitempty"`
+	UserId     string  `json:"user_id"`
+}
+
+// GetUsersReviewStreamParams defines parameters for GetUsersReviewStream.
+type GetUsersReviewStreamParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersUnlinkIdentityJSONBody defines parameters for PostUsersUnlinkIdentity.
+type PostUsersUnlinkIdentityJSONBody struct {
+	Login    string `json:"login"`
+	Provider string `json:"provider"`
+}
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostSubscriptionsAddJSONRequestBody defines body for PostSubscriptionsAdd for application/json ContentType.
+type PostSubscriptionsAddJSONRequestBody PostSubscriptionsAddJSONBody
+
+// PostSubscriptionsDeleteJSONRequestBody defines body for PostSubscriptionsDelete for application/json ContentType.
+type PostSubscriptionsDeleteJSONRequestBody PostSubscriptionsDeleteJSONBody
+
+// PostSubscriptionsRedeliverJSONRequestBody defines body for PostSubscriptionsRedeliver for application/json ContentType.
+type PostSubscriptionsRedeliverJSONRequestBody PostSubscriptionsRedeliverJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamAddMemberJSONRequestBody defines body for PostTeamAddMember for application/json ContentType.
+type PostTeamAddMemberJSONRequestBody = TeamMembership
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamImportMultipartRequestBody defines body for PostTeamImport for multipart/form-data ContentType.
+type PostTeamImportMultipartRequestBody PostTeamImportMultipartBody
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody = TeamMembership
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody PostTeamSetParentJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody PostTeamSettingsJSONBody
+
+// PostUsersLinkIdentityJSONRequestBody defines body for PostUsersLinkIdentity for application/json ContentType.
+type PostUsersLinkIdentityJSONRequestBody = GitIdentity
+
+// PostUsersMoveJSONRequestBody defines body for PostUsersMove for application/json ContentType.
+type PostUsersMoveJSONRequestBody = UserMove
+
+// PostUsersNotificationSettingsJSONRequestBody defines body for PostUsersNotificationSettings for application/json ContentType.
+type PostUsersNotificationSettingsJSONRequestBody PostUsersNotificationSettingsJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersUnlinkIdentityJSONRequestBody defines body for PostUsersUnlinkIdentity for application/json ContentType.
+type PostUsersUnlinkIdentityJSONRequestBody PostUsersUnlinkIdentityJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Проверка работоспособности
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Равномерность нагрузки ревьюверов по командам
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// Статистика ревьюверов за окно [from, to)
+	// (GET /stats/reviewers)
+	GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams)
+	// Пропускная способность и время цикла команд за окно [from, to)
+	// (GET /stats/teams)
+	GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams)
+	// Подписать команду на исходящие вебхуки о событиях ее PR
+	// (POST /subscriptions/add)
+	PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request)
+	// События подписки, которые так и не удалось доставить
+	// (GET /subscriptions/deadLetters)
+	GetSubscriptionsDeadLetters(w http.ResponseWriter, r *http.Request, params GetSubscriptionsDeadLettersParams)
+	// Удалить подписку вместе с ее недоставленными событиями
+	// (POST /subscriptions/delete)
+	PostSubscriptionsDelete(w http.ResponseWriter, r *http.Request)
+	// Подписки команды (без секретов)
+	// (GET /subscriptions/list)
+	GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params GetSubscriptionsListParams)
+	// Поставить недоставленное событие в очередь повторно
+	// (POST /subscriptions/redeliver)
+	PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Добавить существующего пользователя в еще одну команду
+	// (POST /team/addMember)
+	PostTeamAddMember(w http.ResponseWriter, r *http.Request)
+	// Удалить команду, если у ее участников нет открытых ревью
+	// (POST /team/delete)
+	PostTeamDelete(w http.ResponseWriter, r *http.Request)
+	// Выгрузить состав всех команд в CSV
+	// (GET /team/export)
+	GetTeamExport(w http.ResponseWriter, r *http.Request, params GetTeamExportParams)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Импортировать состав команд из CSV (user_id, username, team_name, is_active)
+	// (POST /team/import)
+	PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams)
+	// Исключить пользователя из команды и переназначить его открытые ревью
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(w http.ResponseWriter, r *http.Request)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request)
+	// Переместить команду в иерархии (org → department → team)
+	// (POST /team/setParent)
+	PostTeamSetParent(w http.ResponseWriter, r *http.Request)
+	// Задать собственные настройки команды; незаданные поля наследуются от родителя
+	// (POST /team/settings)
+	PostTeamSettings(w http.ResponseWriter, r *http.Request)
+	// Получить команду с собственными и итоговыми настройками и всем поддеревом дочерних команд
+	// (GET /team/tree)
+	GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams)
+	// Получить карточку пользователя
+	// (GET /users/get)
+	GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Получить логины пользователя во внешних системах
+	// (GET /users/identities)
+	GetUsersIdentities(w http.ResponseWriter, r *http.Request, params GetUsersIdentitiesParams)
+	// Связать логин в GitHub/GitLab/Slack/Mattermost с пользователем
+	// (POST /users/linkIdentity)
+	PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request)
+	// Справочник пользователей с фильтрами, поиском по username и курсорной пагинацией
+	// (GET /users/list)
+	GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams)
+	// Перевести пользователя в другую команду
+	// (POST /users/move)
+	PostUsersMove(w http.ResponseWriter, r *http.Request)
+	// Получить настройки email-уведомлений пользователя
+	// (GET /users/notificationSettings)
+	GetUsersNotificationSettings(w http.ResponseWriter, r *http.Request, params GetUsersNotificationSettingsParams)
+	// Задать адрес и включить или отключить отдельные виды писем
+	// (POST /users/notificationSettings)
+	PostUsersNotificationSettings(w http.ResponseWriter, r *http.Request)
+	// Поток изменений очереди ревью пользователя (Server-Sent Events)
+	// (GET /users/reviewStream)
+	GetUsersReviewStream(w http.ResponseWriter, r *http.Request, params GetUsersReviewStreamParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Удалить связь логина с пользователем
+	// (POST /users/unlinkIdentity)
+	PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Проверка работоспособности
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Равномерность нагрузки ревьюверов по командам
+// (GET /stats/fairness)
+func (_ Unimplemented) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика ревьюверов за окно [from, to)
+// (GET /stats/reviewers)
+func (_ Unimplemented) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пропускная способность и время цикла команд за окно [from, to)
+// (GET /stats/teams)
+func (_ Unimplemented) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подписать команду на исходящие вебхуки о событиях ее PR
+// (POST /subscriptions/add)
+func (_ Unimplemented) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// События подписки, которые так и не удалось доставить
+// (GET /subscriptions/deadLetters)
+func (_ Unimplemented) GetSubscriptionsDeadLetters(w http.ResponseWriter, r *http.Request, params GetSubscriptionsDeadLettersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить подписку вместе с ее недоставленными событиями
+// (POST /subscriptions/delete)
+func (_ Unimplemented) PostSubscriptionsDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подписки команды (без секретов)
+// (GET /subscriptions/list)
+func (_ Unimplemented) GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params GetSubscriptionsListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поставить недоставленное событие в очередь повторно
+// (POST /subscriptions/redeliver)
+func (_ Unimplemented) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить существующего пользователя в еще одну команду
+// (POST /team/addMember)
+func (_ Unimplemented) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить команду, если у ее участников нет открытых ревью
+// (POST /team/delete)
+func (_ Unimplemented) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузить состав всех команд в CSV
+// (GET /team/export)
+func (_ Unimplemented) GetTeamExport(w http.ResponseWriter, r *http.Request, params GetTeamExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импортировать состав команд из CSV (user_id, username, team_name, is_active)
+// (POST /team/import)
+func (_ Unimplemented) PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исключить пользователя из команды и переназначить его открытые ревью
+// (POST /team/removeMember)
+func (_ Unimplemented) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переместить команду в иерархии (org → department → team)
+// (POST /team/setParent)
+func (_ Unimplemented) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать собственные настройки команды; незаданные поля наследуются от родителя
+// (POST /team/settings)
+func (_ Unimplemented) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с собственными и итоговыми настройками и всем поддеревом дочерних команд
+// (GET /team/tree)
+func (_ Unimplemented) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить карточку пользователя
+// (GET /users/get)
+func (_ Unimplemented) GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить логины пользователя во внешних системах
+// (GET /users/identities)
+func (_ Unimplemented) GetUsersIdentities(w http.ResponseWriter, r *http.Request, params GetUsersIdentitiesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Связать логин в GitHub/GitLab/Slack/Mattermost с пользователем
+// (POST /users/linkIdentity)
+func (_ Unimplemented) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Справочник пользователей с фильтрами, поиском по username и курсорной пагинацией
+// (GET /users/list)
+func (_ Unimplemented) GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевести пользователя в другую команду
+// (POST /users/move)
+func (_ Unimplemented) PostUsersMove(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки email-уведомлений пользователя
+// (GET /users/notificationSettings)
+func (_ Unimplemented) GetUsersNotificationSettings(w http.ResponseWriter, r *http.Request, params GetUsersNotificationSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать адрес и включить или отключить отдельные виды писем
+// (POST /users/notificationSettings)
+func (_ Unimplemented) PostUsersNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток изменений очереди ревью пользователя (Server-Sent Events)
+// (GET /users/reviewStream)
+func (_ Unimplemented) GetUsersReviewStream(w http.ResponseWriter, r *http.Request, params GetUsersReviewStreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить связь логина с пользователем
+// (POST /users/unlinkIdentity)
+func (_ Unimplemented) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "z_threshold" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "z_threshold", r.URL.Query(), &params.ZThreshold)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "z_threshold", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewersParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsDeadLettersParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := r.URL.Query().Get("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "subscription_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", r.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsList operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsListParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsRedeliver(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAddMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAddMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamExport operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamExport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_subtree" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_subtree", r.URL.Query(), &params.IncludeSubtree)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_subtree", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetParent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamTree(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+	var err error
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
-}
+	// ------------- Required query parameter "user_id" -------------
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
-type Unimplemented struct{}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-// (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
-// Пометить PR как MERGED (идемпотентная операция)
-// (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
 
-// Переназначить конкретного ревьювера на другого из его команды
-// (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r)
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// GetUsersIdentities operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersIdentities(w http.ResponseWriter, r *http.Request) {
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersIdentitiesParams
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Required query parameter "user_id" -------------
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.GetUsersIdentities(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -243,11 +1696,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostUsersLinkIdentity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostUsersLinkIdentity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -257,11 +1710,72 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// GetUsersList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersListParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", r.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.GetUsersList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -271,11 +1785,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostUsersMove operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersMove(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostUsersMove(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -285,31 +1799,31 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetUsersNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersNotificationSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetUsersNotificationSettingsParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "user_id" -------------
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetUsersNotificationSettings(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -319,13 +1833,27 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostUsersNotificationSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersNotificationSettings(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersNotificationSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersReviewStream operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersReviewStream(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params GetUsersReviewStreamParams
 
 	// ------------- Required query parameter "user_id" -------------
 
@@ -343,7 +1871,7 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.GetUsersReviewStream(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -367,6 +1895,20 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// PostUsersUnlinkIdentity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersUnlinkIdentity(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -480,6 +2022,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
@@ -489,18 +2034,96 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/teams", wrapper.GetStatsTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/add", wrapper.PostSubscriptionsAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/deadLetters", wrapper.GetSubscriptionsDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/delete", wrapper.PostSubscriptionsDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/list", wrapper.GetSubscriptionsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/redeliver", wrapper.PostSubscriptionsRedeliver)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMember", wrapper.PostTeamAddMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/export", wrapper.GetTeamExport)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.PostTeamImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setParent", wrapper.PostTeamSetParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/settings", wrapper.PostTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/tree", wrapper.GetTeamTree)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/get", wrapper.GetUsersGet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/identities", wrapper.GetUsersIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/linkIdentity", wrapper.PostUsersLinkIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/list", wrapper.GetUsersList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/move", wrapper.PostUsersMove)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/notificationSettings", wrapper.GetUsersNotificationSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/notificationSettings", wrapper.PostUsersNotificationSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/reviewStream", wrapper.GetUsersReviewStream)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/unlinkIdentity", wrapper.PostUsersUnlinkIdentity)
+	})
 
 	return r
 }
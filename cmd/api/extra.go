@@ -0,0 +1,56 @@
+package api
+
+// Значения по умолчанию и внутренние типы, которых нет в OpenAPI-спецификации.
+
+// Значения настроек по умолчанию для команд, у которых ни одна из команд-предков
+// не задала собственное значение.
+const (
+	DefaultReviewerCount  = 2
+	DefaultReviewStrategy = ReviewStrategyRANDOM
+)
+
+// Inherit возвращает настройки, в которых заданные поля own перекрывают текущие.
+func (e EffectiveTeamSettings) Inherit(own TeamSettings) EffectiveTeamSettings {
+	if own.ReviewerCount != nil {
+		e.ReviewerCount = *own.ReviewerCount
+	}
+	if own.Strategy != nil {
+		e.Strategy = *own.Strategy
+	}
+	if own.SlaHours != nil {
+		e.SlaHours = *own.SlaHours
+	}
+	return e
+}
+
+// RosterRow — строка CSV-файла с составом команд (/team/import и /team/export).
+// Line — номер строки в исходном файле, используется только в отчете об ошибках.
+type RosterRow struct {
+	Line     int    `json:"-"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+// ReviewEventType определяет вид события в истории назначений ревьюверов.
+type ReviewEventType string
+
+// Defines values for ReviewEventType.
+const (
+	ReviewEventASSIGNED    ReviewEventType = "ASSIGNED"
+	ReviewEventUNASSIGNED  ReviewEventType = "UNASSIGNED"
+	ReviewEventNOCANDIDATE ReviewEventType = "NO_CANDIDATE"
+)
+
+// ReviewEvent — запись в истории назначений ревьюверов.
+type ReviewEvent struct {
+	PullRequestId string
+	UserId        string
+	Type          ReviewEventType
+	TeamName      string
+	Strategy      ReviewStrategy
+}
+
+// DefaultFairnessZThreshold — порог |z-score|, начиная с которого участник считается выбросом.
+const DefaultFairnessZThreshold = 1.5
@@ -5,19 +5,41 @@ import (
 	"deplagene/avito-tech-internship/cmd/api"
 	"deplagene/avito-tech-internship/configs"
 	"deplagene/avito-tech-internship/db"
+	"deplagene/avito-tech-internship/internal/chatops"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/grpcapi"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/internal/notify"
+	"deplagene/avito-tech-internship/internal/outbound"
+	"deplagene/avito-tech-internship/internal/outbox"
 	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/scim"
+	"deplagene/avito-tech-internship/internal/stats"
+	"deplagene/avito-tech-internship/internal/stream"
 	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/tracing"
 	"deplagene/avito-tech-internship/internal/user"
+	"deplagene/avito-tech-internship/internal/webhook"
+	"deplagene/avito-tech-internship/types"
 	"deplagene/avito-tech-internship/utils"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
+	// База часовых поясов для DIGEST_TIMEZONE: в образе alpine ее нет
+	_ "time/tzdata"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -28,8 +50,27 @@ func main() {
 	logger := utils.NewLogger(cfg.Env)
 	slog.SetDefault(logger) // Set default logger for convenience
 
+	// Подкоманда reconcile сверяет состав команд с YAML-файлом и завершает работу
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		os.Exit(runReconcile(cfg, logger, os.Args[2:]))
+	}
+
 	logger.Info("Starting application", "env", cfg.Env, "port", cfg.HTTPPort)
 
+	// Настраиваем трассировку
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.TracesExporter)
+	if err != nil {
+		logger.Error("Failed to init tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
 	// Подключаемся к БД
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -42,29 +83,143 @@ func main() {
 	defer pool.Close()
 	logger.Info("Connected to database")
 
+	// Статистика пула читается из памяти при каждом scrape, без запросов к БД
+	prometheus.MustRegister(metrics.NewPoolCollector(pool))
+
 	// Инициализируем репозитории
 	teamRepo := team.NewTeamRepository(pool)
 	userRepo := user.NewUserRepository(pool)
 	prRepo := pullrequest.NewPullRequestRepository(pool)
+	statsRepo := stats.NewStatsRepository(pool)
+
+	// Без брокера общий outbox не заполняется и relay не запускается
+	publisher, err := outbox.NewPublisher(outboxConfig(cfg))
+	if err != nil {
+		logger.Error("Failed to init outbox publisher", "error", err)
+		os.Exit(1)
+	}
+	outboundRepo := outbound.NewRepository(pool)
+	outboundService := outbound.NewService(outboundRepo, teamRepo, pool, logger)
+	outboxRepo := outbox.NewRepository(pool)
+	if publisher == nil {
+		logger.Info("OUTBOX_PUBLISHER is none, domain events are not published to a broker")
+	}
+	// Назначения ревьюверов ставятся в очередь уведомлений для каждого включенного канала
+	notifyRepo := notify.NewRepository(pool)
+	notifiers, err := notifySenders(cfg, notifyRepo, pool)
+	if err != nil {
+		logger.Error("Failed to init reviewer notifications", "error", err)
+		os.Exit(1)
+	}
+	if len(notifiers) == 0 {
+		logger.Info("CHAT_CONFIG and SMTP_HOST are not set, reviewer notifications are disabled")
+	}
+	eventSinks := domainEventSinks(cfg, pool, outboundService, notifyRepo, notifiers)
+	eventRecorder := events.NewRecorder(eventSinks...)
 
 	// Инициализируем сервисы
-	teamService := team.NewService(teamRepo, userRepo, pool, logger)
-	userService := user.NewService(userRepo, pool, logger)
-	prService := pullrequest.NewService(prRepo, userRepo, teamRepo, pool, logger)
+	prService := pullrequest.NewService(prRepo, userRepo, teamRepo, eventRecorder, pool, logger)
+	teamService := team.NewService(teamRepo, userRepo, prService, eventRecorder, pool, logger)
+	userService := user.NewService(userRepo, teamRepo, prService, eventRecorder, pool, logger)
+	statsService := stats.NewService(statsRepo, teamRepo, pool, logger)
+	webhookService := webhook.NewService(webhook.NewRepository(pool), userRepo, prService, pool, logger)
+	notificationSettings := notify.NewService(notifyRepo, userRepo, pool, logger)
 
 	// Создаем хендлер
-	apiHandler := pullrequest.NewHandler(teamService, userService, prService, logger)
+	apiHandler := pullrequest.NewHandler(teamService, userService, prService, statsService, webhookService, outboundService, notificationSettings, logger)
 
 	// Настройка роутера Chi
 	router := chi.NewRouter()
-	router.Use(middleware.Logger)
+	router.Use(tracing.Middleware)
+	router.Use(logging.Middleware(logger))
+	router.Use(metrics.Middleware)
 	router.Use(middleware.Recoverer)
 
-	// Регистрируем роуты, который сгнерерил oapi-codegen
-	api.HandlerWithOptions(apiHandler, api.ChiServerOptions{BaseRouter: router})
+	// Регистрируем роуты, которые сгенерировал oapi-codegen по cmd/api/openapi.yml.
+	// Ошибки разбора query-параметров отдаются в том же формате, что и ошибки хендлеров
+	reviewStreams := stream.NewHub(stream.NewRepository(pool), logger)
+	serverHandler := apiServer{Handler: apiHandler, reviewStream: stream.NewHandler(reviewStreams, userService, logger)}
+	api.HandlerWithOptions(serverHandler, api.ChiServerOptions{
+		BaseRouter: router,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			utils.WriteError(w, logging.FromContext(r.Context(), logger), http.StatusBadRequest, err)
+		},
+	})
+	router.Handle("/metrics", promhttp.Handler())
+
+	// SCIM 2.0 для автоматического провижининга из провайдера учетных записей
+	if cfg.ScimToken != "" {
+		scimService := scim.NewService(scim.NewRepository(pool), userRepo, teamRepo, prService, eventRecorder, pool, logger)
+		router.Mount("/scim/v2", scim.NewHandler(scimService, cfg.ScimToken, logger).Routes())
+	} else {
+		logger.Info("SCIM_TOKEN is not set, /scim/v2 is disabled")
+	}
+
+	// Вебхуки GitHub/GitLab: события PR применяются к PR сервиса
+	webhookCfg := webhook.Config{GitHubSecret: cfg.GitHubWebhookSecret, GitLabToken: cfg.GitLabWebhookToken}
+	if webhookCfg.GitHubSecret == "" {
+		logger.Info("GITHUB_WEBHOOK_SECRET is not set, /webhooks/github is disabled")
+	}
+	if webhookCfg.GitLabToken == "" {
+		logger.Info("GITLAB_WEBHOOK_TOKEN is not set, /webhooks/gitlab is disabled")
+	}
+	router.Mount("/webhooks", webhook.NewHandler(webhookService, webhookCfg, logger).Routes())
 
-	// Дополнительные роуты (health-check)
-	router.Get("/health", apiHandler.GetHealth)
+	// Slash-команды чатов от имени пользователя, связанного через /users/linkIdentity
+	chatopsCfg := chatops.Config{SlackSigningSecret: cfg.SlackSigningSecret, MattermostToken: cfg.MattermostToken}
+	if chatopsCfg.SlackSigningSecret == "" && chatopsCfg.MattermostToken == "" {
+		logger.Info("CHATOPS_SLACK_SIGNING_SECRET and CHATOPS_MATTERMOST_TOKEN are not set, /chatops/command is disabled")
+	}
+	chatopsService := chatops.NewService(prService, userService, webhookService, logger)
+	router.Mount("/chatops", chatops.NewHandler(chatopsService, chatopsCfg, logger).Routes())
+
+	// Доставка исходящих вебхуков, relay outbox, уведомления и дайджест работают до остановки сервера
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	dispatcher := outbound.NewDispatcher(outboundRepo, pool, outbound.DispatcherConfig{MaxAttempts: cfg.WebhookMaxAttempts}, logger)
+	workers.Go(func() { dispatcher.Run(workersCtx) })
+	workers.Go(func() { reviewStreams.Run(workersCtx) })
+	if publisher != nil {
+		relay := outbox.NewRelay(outboxRepo, pool, publisher, outbox.RelayConfig{}, logger)
+		workers.Go(func() {
+			relay.Run(workersCtx)
+			if err := publisher.Close(); err != nil {
+				logger.Error("Failed to close outbox publisher", "error", err)
+			}
+		})
+	}
+
+	if len(notifiers) > 0 {
+		notifyWorker, err := notify.NewWorker(notifyRepo, prRepo, userRepo, pool, notifiers,
+			notify.WorkerConfig{LinkTemplate: cfg.NotifyLinkTemplate}, logger)
+		if err != nil {
+			logger.Error("Failed to init notification worker", "error", err)
+			os.Exit(1)
+		}
+		workers.Go(func() { notifyWorker.Run(workersCtx) })
+	}
+	for _, sender := range notifiers {
+		email, ok := sender.(*notify.EmailSender)
+		if !ok {
+			continue
+		}
+		location, err := time.LoadLocation(cfg.DigestTimezone)
+		if err != nil {
+			logger.Error("Invalid DIGEST_TIMEZONE", "error", err)
+			os.Exit(1)
+		}
+		digester, err := notify.NewDigester(notifyRepo, prRepo, userRepo, pool, email, notify.DigestConfig{
+			At:           cfg.DigestTime,
+			Location:     location,
+			LinkTemplate: cfg.NotifyLinkTemplate,
+		}, logger)
+		if err != nil {
+			logger.Error("Failed to init review digest", "error", err)
+			os.Exit(1)
+		}
+		workers.Go(func() { digester.Run(workersCtx) })
+	}
 
 	// Запускаем сервер
 	server := &http.Server{
@@ -74,6 +229,27 @@ func main() {
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
+	// Потоки SSE не завершаются сами, поэтому при остановке они закрываются до ожидания запросов
+	server.RegisterOnShutdown(reviewStreams.Close)
+
+	// gRPC API на отдельном порту поверх тех же сервисов
+	var grpcServer *grpc.Server
+	if cfg.GRPCPort != "" {
+		listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			logger.Error("Failed to listen gRPC port", "error", err)
+			os.Exit(1)
+		}
+		grpcServer = grpcapi.NewServer(teamService, userService, prService, logger)
+		go func() {
+			logger.Info("gRPC server listening", "port", cfg.GRPCPort)
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Error("gRPC server failed", "error", err)
+			}
+		}()
+	} else {
+		logger.Info("GRPC_PORT is not set, gRPC API is disabled")
+	}
 
 	// Graceful shutdown
 	go func() {
@@ -88,6 +264,20 @@ func main() {
 		if err := server.Shutdown(shutdownCtx); err != nil {
 			logger.Error("Server shutdown failed", "error", err)
 		}
+		if grpcServer != nil {
+			// GracefulStop ждет завершения вызовов без ограничения, поэтому по таймауту они обрываются
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-shutdownCtx.Done():
+				grpcServer.Stop()
+			}
+		}
+		stopWorkers()
 	}()
 
 	logger.Info("Server listening", "port", cfg.HTTPPort)
@@ -95,5 +285,73 @@ func main() {
 		logger.Error("Server failed to start", "error", err)
 		os.Exit(1)
 	}
+	workers.Wait()
 	logger.Info("Server stopped")
 }
+
+// apiServer дополняет хендлер REST API потоком очереди ревью: вместе они реализуют api.ServerInterface.
+type apiServer struct {
+	*pullrequest.Handler
+	reviewStream *stream.Handler
+}
+
+func (s apiServer) GetUsersReviewStream(w http.ResponseWriter, r *http.Request, params api.GetUsersReviewStreamParams) {
+	s.reviewStream.GetUsersReviewStream(w, r, params)
+}
+
+// outboxConfig собирает настройки публикации outbox из конфига.
+func outboxConfig(cfg *configs.Config) outbox.PublisherConfig {
+	return outbox.PublisherConfig{
+		Kind:              cfg.OutboxPublisher,
+		FilePath:          cfg.OutboxFile,
+		NatsURL:           cfg.NatsURL,
+		NatsSubjectPrefix: cfg.NatsSubjectPrefix,
+		KafkaBrokers:      cfg.KafkaBrokers,
+		KafkaTopic:        cfg.KafkaTopic,
+	}
+}
+
+// domainEventSinks собирает получателей доменных событий: outbox исходящих вебхуков, общий outbox
+// (если задан брокер), очередь уведомлений (если включен хотя бы один канал) и NOTIFY для потоков
+// /users/reviewStream. Список общий для сервера и reconcile, чтобы изменения из CLI видели те же потребители.
+func domainEventSinks(cfg *configs.Config, pool *pgxpool.Pool, outboundService *outbound.Service, notifyRepo *notify.Repository, notifiers []notify.Sender) []types.EventRecorder {
+	sinks := []types.EventRecorder{outboundService}
+	if outboxConfig(cfg).Enabled() {
+		sinks = append(sinks, outbox.NewRecorder(outbox.NewRepository(pool)))
+	}
+	if len(notifiers) > 0 {
+		sinks = append(sinks, notify.NewRecorder(notifyRepo, notifiers...))
+	}
+	return append(sinks, stream.NewRecorder(stream.NewRepository(pool)))
+}
+
+// notifySenders создает включенные каналы уведомлений ревьюверам.
+func notifySenders(cfg *configs.Config, repo *notify.Repository, pool *pgxpool.Pool) ([]notify.Sender, error) {
+	var senders []notify.Sender
+	if cfg.ChatConfig != "" {
+		chatCfg, err := notify.LoadChatConfig(cfg.ChatConfig)
+		if err != nil {
+			return nil, err
+		}
+		chat, err := notify.NewChatSender(*chatCfg)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, chat)
+	}
+	if cfg.SMTPHost != "" {
+		email, err := notify.NewEmailSender(notify.SMTPConfig{
+			Host:        cfg.SMTPHost,
+			Port:        cfg.SMTPPort,
+			Username:    cfg.SMTPUsername,
+			Password:    cfg.SMTPPassword,
+			From:        cfg.SMTPFrom,
+			TemplateDir: cfg.EmailTemplateDir,
+		}, repo, pool)
+		if err != nil {
+			return nil, err
+		}
+		senders = append(senders, email)
+	}
+	return senders, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/configs"
+	"deplagene/avito-tech-internship/db"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/notify"
+	"deplagene/avito-tech-internship/internal/outbound"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/roster"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/user"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os/signal"
+	"syscall"
+)
+
+// runReconcile сверяет состав команд в БД с YAML-файлом.
+// Без --apply только печатает план, с --apply применяет его в одной транзакции.
+func runReconcile(cfg *configs.Config, logger *slog.Logger, args []string) int {
+	flags := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	file := flags.String("file", "roster.yaml", "path to the team roster YAML file")
+	apply := flags.Bool("apply", false, "apply the plan instead of only printing it")
+	prune := flags.Bool("prune", false, "delete teams that are not listed in the file")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	desired, err := roster.Load(*file)
+	if err != nil {
+		logger.Error("Failed to load roster", "error", err)
+		return 1
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := db.New(ctx, cfg.DatabaseURL)
+	if err != nil {
+		logger.Error("Failed to connect to database", "error", err)
+		return 1
+	}
+	defer pool.Close()
+
+	teamRepo := team.NewTeamRepository(pool)
+	userRepo := user.NewUserRepository(pool)
+	prRepo := pullrequest.NewPullRequestRepository(pool)
+
+	// Изменения при сверке порождают те же события, что и API; доставят и опубликуют их воркеры сервера
+	notifyRepo := notify.NewRepository(pool)
+	notifiers, err := notifySenders(cfg, notifyRepo, pool)
+	if err != nil {
+		logger.Error("Failed to init reviewer notifications", "error", err)
+		return 1
+	}
+	outboundService := outbound.NewService(outbound.NewRepository(pool), teamRepo, pool, logger)
+	eventSinks := domainEventSinks(cfg, pool, outboundService, notifyRepo, notifiers)
+	eventRecorder := events.NewRecorder(eventSinks...)
+
+	prService := pullrequest.NewService(prRepo, userRepo, teamRepo, eventRecorder, pool, logger)
+	teamService := team.NewService(teamRepo, userRepo, prService, eventRecorder, pool, logger)
+	userService := user.NewService(userRepo, teamRepo, prService, eventRecorder, pool, logger)
+
+	reconciler := roster.NewReconciler(teamService, userService, pool, logger)
+
+	plan, err := reconciler.Plan(ctx, desired, *prune)
+	if err != nil {
+		logger.Error("Failed to build reconcile plan", "error", err)
+		return 1
+	}
+
+	if len(plan.Steps) == 0 {
+		fmt.Println("Roster is up to date, nothing to do.")
+		return 0
+	}
+
+	fmt.Printf("Plan: %d change(s)\n", len(plan.Steps))
+	for _, step := range plan.Steps {
+		fmt.Println("  " + step.String())
+	}
+
+	if !*apply {
+		fmt.Println("Dry run, rerun with --apply to apply the plan.")
+		return 0
+	}
+
+	if err := reconciler.Apply(ctx, plan); err != nil {
+		logger.Error("Failed to apply reconcile plan", "error", err)
+		return 1
+	}
+	fmt.Println("Plan applied.")
+	return 0
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"deplagene/avito-tech-internship/internal/tracing"
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -10,7 +11,14 @@ import (
 func New(ctx context.Context, databaseURL string) (*pgxpool.Pool, error) {
 	const op = "db.New"
 
-	pool, err := pgxpool.New(ctx, databaseURL)
+	cfg, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// Спаны SQL-запросов; без настроенного экспортера трассировка ничего не стоит
+	cfg.ConnConfig.Tracer = tracing.NewQueryTracer()
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -0,0 +1,148 @@
+package chatops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/webhook"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Заголовки подписи запросов Slack.
+const (
+	slackSignatureHeader = "X-Slack-Signature"
+	slackTimestampHeader = "X-Slack-Request-Timestamp"
+)
+
+// maxCommandSize ограничивает размер формы slash-команды.
+const maxCommandSize = 64 << 10
+
+// maxClockSkew — насколько подписанный запрос Slack может быть старше текущего времени;
+// более старые отклоняются как повтор перехваченного запроса.
+const maxClockSkew = 5 * time.Minute
+
+// Config задает секреты чатов. Чат с пустым секретом не принимается.
+type Config struct {
+	// SlackSigningSecret — Signing Secret приложения Slack.
+	SlackSigningSecret string
+	// MattermostToken — токен slash-команды Mattermost.
+	MattermostToken string
+}
+
+// commandResponse — ответ на slash-команду, который видит только ее автор.
+type commandResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+type Handler struct {
+	service *Service
+	cfg     Config
+	logger  *slog.Logger
+}
+
+func NewHandler(service *Service, cfg Config, logger *slog.Logger) *Handler {
+	return &Handler{
+		service: service,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+func (h *Handler) log(r *http.Request) *slog.Logger {
+	return logging.FromContext(r.Context(), h.logger)
+}
+
+// Routes возвращает роутер chat-ops, который монтируется в /chatops.
+func (h *Handler) Routes() http.Handler {
+	router := chi.NewRouter()
+	router.Post("/command", h.command)
+	return router
+}
+
+// command принимает slash-команду Slack или Mattermost. Запрос Slack распознается по заголовку
+// подписи, запрос Mattermost — по токену команды в форме.
+func (h *Handler) command(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCommandSize))
+	if err != nil {
+		http.Error(w, "failed to read request", http.StatusBadRequest)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	var provider, login string
+	switch {
+	case h.cfg.SlackSigningSecret != "" && r.Header.Get(slackSignatureHeader) != "":
+		if !verifySlackSignature(h.cfg.SlackSigningSecret, body, r.Header.Get(slackTimestampHeader), r.Header.Get(slackSignatureHeader), time.Now()) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		provider, login = webhook.ProviderSlack, form.Get("user_id")
+	case h.cfg.MattermostToken != "" && form.Get("token") != "":
+		if subtle.ConstantTimeCompare([]byte(form.Get("token")), []byte(h.cfg.MattermostToken)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		// user_name пользователь может сменить, а связь с пользователем сервиса должна остаться прежней
+		provider, login = webhook.ProviderMattermost, form.Get("user_id")
+	default:
+		http.Error(w, "unsigned request", http.StatusUnauthorized)
+		return
+	}
+	if login == "" {
+		http.Error(w, "user is required", http.StatusBadRequest)
+		return
+	}
+
+	reply, err := h.service.Execute(r.Context(), provider, login, form.Get("text"))
+	if err != nil {
+		// Чат показывает пользователю только ответ 200, поэтому сбой тоже отдается текстом
+		h.log(r).ErrorContext(r.Context(), "chat command failed", "provider", provider, utils.Err(err))
+		reply = "Something went wrong, please try again later."
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, commandResponse{ResponseType: "ephemeral", Text: reply}); err != nil {
+		h.log(r).ErrorContext(r.Context(), "failed to write chat command response", utils.Err(err))
+	}
+}
+
+// verifySlackSignature проверяет подпись Slack: "v0=" + hex(HMAC-SHA256("v0:<timestamp>:<body>"))
+// с Signing Secret приложения. Запросы с меткой времени дальше maxClockSkew от now отклоняются.
+func verifySlackSignature(secret string, body []byte, timestamp, header string, now time.Time) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if skew := now.Sub(time.Unix(ts, 0)); skew > maxClockSkew || skew < -maxClockSkew {
+		return false
+	}
+
+	signature, ok := strings.CutPrefix(header, "v0=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
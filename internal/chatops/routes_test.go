@@ -0,0 +1,77 @@
+package chatops_test
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/internal/chatops"
+	"deplagene/avito-tech-internship/internal/webhook"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// unlinkedIdentities запоминает, по какому логину искали пользователя, и никого не находит.
+type unlinkedIdentities struct {
+	types.IdentityService
+	provider, login string
+}
+
+func (u *unlinkedIdentities) ResolveIdentity(_ context.Context, provider, login string) (string, error) {
+	u.provider, u.login = provider, login
+	return "", types.ErrUnknownIdentity
+}
+
+func TestMattermostCallerIdentifiedByUserID(t *testing.T) {
+	identities := &unlinkedIdentities{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := chatops.NewService(nil, nil, identities, logger)
+	router := chatops.NewHandler(service, chatops.Config{MattermostToken: "mm-token"}, logger).Routes()
+
+	form := url.Values{
+		"token":     {"mm-token"},
+		"user_id":   {"x7ak3fwqmbrz8yczgsn5uh1d4e"},
+		"user_name": {"bob"},
+		"text":      {"queue"},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/command", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d, want 200: %s", rec.Code, rec.Body)
+	}
+	if identities.provider != webhook.ProviderMattermost || identities.login != "x7ak3fwqmbrz8yczgsn5uh1d4e" {
+		t.Errorf("resolved %s login %q, want mattermost user_id", identities.provider, identities.login)
+	}
+
+	var resp struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !strings.Contains(resp.Text, `login "x7ak3fwqmbrz8yczgsn5uh1d4e"`) {
+		t.Errorf("reply %q does not tell which login to link", resp.Text)
+	}
+}
+
+func TestMattermostRequiresUserID(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := chatops.NewService(nil, nil, &unlinkedIdentities{}, logger)
+	router := chatops.NewHandler(service, chatops.Config{MattermostToken: "mm-token"}, logger).Routes()
+
+	form := url.Values{"token": {"mm-token"}, "user_name": {"bob"}, "text": {"queue"}}
+	req := httptest.NewRequest(http.MethodPost, "/command", strings.NewReader(form.Encode()))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want 400 without user_id", rec.Code)
+	}
+}
@@ -0,0 +1,144 @@
+package chatops
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// usage — справка по командам, которая возвращается на help и неизвестную команду.
+const usage = "Usage:\n" +
+	"• `/review queue` — your open reviews\n" +
+	"• `/review ooo on|off` — stop or resume getting new reviews\n" +
+	"• `/review reassign <pr>` — hand your review of a PR over to a teammate"
+
+// Service выполняет slash-команды от имени пользователя сервиса, связанного с пользователем чата.
+type Service struct {
+	prService   types.PullRequestService
+	userService types.UserService
+	identities  types.IdentityService
+	logger      *slog.Logger
+}
+
+func NewService(
+	prService types.PullRequestService,
+	userService types.UserService,
+	identities types.IdentityService,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		prService:   prService,
+		userService: userService,
+		identities:  identities,
+		logger:      logger,
+	}
+}
+
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// Execute выполняет команду text пользователя чата login и возвращает ответ для него.
+// Ошибки, понятные пользователю (PR не найден, замены нет и т.п.), возвращаются текстом ответа;
+// error означает внутренний сбой.
+func (s *Service) Execute(ctx context.Context, provider, login, text string) (string, error) {
+	const op = "chatops.service.Execute"
+
+	args := strings.Fields(text)
+	if len(args) == 0 || args[0] == "help" {
+		return usage, nil
+	}
+
+	userID, err := s.identities.ResolveIdentity(ctx, provider, login)
+	if errors.Is(err, types.ErrUnknownIdentity) {
+		return fmt.Sprintf("Your %s account is not linked to a review service user. "+
+			"Ask an admin to link it: POST /users/linkIdentity with provider %q and login %q.", provider, provider, login), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log(ctx).InfoContext(ctx, "chat command", "provider", provider, "user_id", userID, "command", args[0])
+
+	var reply string
+	switch {
+	case args[0] == "queue" && len(args) == 1:
+		reply, err = s.queue(ctx, userID)
+	case args[0] == "ooo" && len(args) == 2 && (args[1] == "on" || args[1] == "off"):
+		reply, err = s.outOfOffice(ctx, userID, args[1] == "on")
+	case args[0] == "reassign" && len(args) == 2:
+		reply, err = s.reassign(ctx, userID, args[1])
+	default:
+		return usage, nil
+	}
+	if err != nil {
+		if message, ok := userMessage(err); ok {
+			return message, nil
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return reply, nil
+}
+
+// queue перечисляет открытые PR, где пользователь назначен ревьювером.
+func (s *Service) queue(ctx context.Context, userID string) (string, error) {
+	prs, err := s.prService.GetPullRequestsByReviewer(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	for _, pr := range prs {
+		if pr.Status == api.PullRequestShortStatusOPEN {
+			lines = append(lines, fmt.Sprintf("• %s (`%s`) by %s", pr.PullRequestName, pr.PullRequestId, pr.AuthorId))
+		}
+	}
+	if len(lines) == 0 {
+		return "Your review queue is empty.", nil
+	}
+	return fmt.Sprintf("Open reviews (%d):\n%s", len(lines), strings.Join(lines, "\n")), nil
+}
+
+// outOfOffice снимает или возвращает пользователю признак активности.
+func (s *Service) outOfOffice(ctx context.Context, userID string, away bool) (string, error) {
+	if _, err := s.userService.SetUserIsActive(ctx, userID, !away); err != nil {
+		return "", err
+	}
+	if away {
+		return "You are out of office: new reviews will not be assigned to you. " +
+			"Reviews you already have stay with you, hand them over with `/review reassign <pr>`.", nil
+	}
+	return "Welcome back: you will get new reviews again.", nil
+}
+
+// reassign передает ревью PR пользователя другому участнику команды.
+func (s *Service) reassign(ctx context.Context, userID, prID string) (string, error) {
+	_, newReviewerID, err := s.prService.ReassignReviewer(ctx, prID, userID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Done: `%s` is now reviewed by %s.", prID, newReviewerID), nil
+}
+
+// userMessage переводит доменную ошибку в ответ пользователю.
+func userMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, types.ErrNotFound):
+		return "Not found: check the pull request ID.", true
+	case errors.Is(err, types.ErrNotAssigned):
+		return "You are not a reviewer of this pull request.", true
+	case errors.Is(err, types.ErrPRMerged):
+		return "This pull request is already merged.", true
+	case errors.Is(err, types.ErrPRClosed):
+		return "This pull request is closed.", true
+	case errors.Is(err, types.ErrNoCandidate):
+		return "Nobody in the team can take this review right now.", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Recorder — точка, через которую сервисы записывают доменные события. Событие получает
+// ID и время и передается всем получателям (outbox вебхуков, outbox брокера) в той же транзакции.
+// Ошибка любого получателя откатывает транзакцию вызывающего.
+type Recorder struct {
+	sinks []types.EventRecorder
+}
+
+func NewRecorder(sinks ...types.EventRecorder) *Recorder {
+	return &Recorder{sinks: sinks}
+}
+
+func (r *Recorder) Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error {
+	if event.Id == "" {
+		event.Id = uuid.NewString()
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	for _, sink := range r.sinks {
+		if err := sink.Record(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.EventRecorder = (*Recorder)(nil)
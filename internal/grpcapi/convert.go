@@ -0,0 +1,226 @@
+package grpcapi
+
+import (
+	"deplagene/avito-tech-internship/cmd/api"
+	reviewv1 "deplagene/avito-tech-internship/proto/review/v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Преобразования между типами cmd/api, с которыми работают сервисы, и сообщениями protobuf.
+
+func teamToProto(team *api.Team) *reviewv1.Team {
+	if team == nil {
+		return nil
+	}
+	return &reviewv1.Team{
+		TeamName: team.TeamName,
+		Members:  membersToProto(team.Members),
+	}
+}
+
+func teamFromProto(team *reviewv1.Team) api.Team {
+	members := make([]api.TeamMember, 0, len(team.GetMembers()))
+	for _, m := range team.GetMembers() {
+		members = append(members, api.TeamMember{
+			UserId:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		})
+	}
+	return api.Team{TeamName: team.GetTeamName(), Members: members}
+}
+
+func membersToProto(members []api.TeamMember) []*reviewv1.TeamMember {
+	out := make([]*reviewv1.TeamMember, 0, len(members))
+	for _, m := range members {
+		out = append(out, &reviewv1.TeamMember{
+			UserId:   m.UserId,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+	return out
+}
+
+func teamNodeToProto(node *api.TeamNode) *reviewv1.TeamNode {
+	if node == nil {
+		return nil
+	}
+	out := &reviewv1.TeamNode{
+		TeamName:   node.TeamName,
+		ParentTeam: node.ParentTeam,
+		Members:    membersToProto(node.Members),
+		Settings:   settingsToProto(node.Settings),
+		EffectiveSettings: &reviewv1.EffectiveTeamSettings{
+			ReviewerCount: int32(node.EffectiveSettings.ReviewerCount),
+			Strategy:      strategyToProto(node.EffectiveSettings.Strategy),
+			SlaHours:      int32(node.EffectiveSettings.SlaHours),
+		},
+	}
+	for i := range node.Subteams {
+		out.Subteams = append(out.Subteams, teamNodeToProto(&node.Subteams[i]))
+	}
+	return out
+}
+
+func settingsToProto(settings api.TeamSettings) *reviewv1.TeamSettings {
+	out := &reviewv1.TeamSettings{}
+	if settings.ReviewerCount != nil {
+		n := int32(*settings.ReviewerCount)
+		out.ReviewerCount = &n
+	}
+	if settings.Strategy != nil {
+		strategy := strategyToProto(*settings.Strategy)
+		out.Strategy = &strategy
+	}
+	if settings.SlaHours != nil {
+		n := int32(*settings.SlaHours)
+		out.SlaHours = &n
+	}
+	return out
+}
+
+func settingsFromProto(settings *reviewv1.TeamSettings) api.TeamSettings {
+	var out api.TeamSettings
+	if settings == nil {
+		return out
+	}
+	if settings.ReviewerCount != nil {
+		n := int(settings.GetReviewerCount())
+		out.ReviewerCount = &n
+	}
+	if settings.Strategy != nil {
+		// Неизвестная стратегия передается как есть и отклоняется сервисом как INVALID_INPUT
+		strategy := strategyFromProto(settings.GetStrategy())
+		out.Strategy = &strategy
+	}
+	if settings.SlaHours != nil {
+		n := int(settings.GetSlaHours())
+		out.SlaHours = &n
+	}
+	return out
+}
+
+func strategyToProto(strategy api.ReviewStrategy) reviewv1.ReviewStrategy {
+	switch strategy {
+	case api.ReviewStrategyRANDOM:
+		return reviewv1.ReviewStrategy_REVIEW_STRATEGY_RANDOM
+	case api.ReviewStrategyLEASTLOADED:
+		return reviewv1.ReviewStrategy_REVIEW_STRATEGY_LEAST_LOADED
+	default:
+		return reviewv1.ReviewStrategy_REVIEW_STRATEGY_UNSPECIFIED
+	}
+}
+
+func strategyFromProto(strategy reviewv1.ReviewStrategy) api.ReviewStrategy {
+	switch strategy {
+	case reviewv1.ReviewStrategy_REVIEW_STRATEGY_RANDOM:
+		return api.ReviewStrategyRANDOM
+	case reviewv1.ReviewStrategy_REVIEW_STRATEGY_LEAST_LOADED:
+		return api.ReviewStrategyLEASTLOADED
+	default:
+		return api.ReviewStrategy(strategy.String())
+	}
+}
+
+func importResultToProto(result *api.TeamImportResult) *reviewv1.ImportTeamsCSVResponse {
+	out := &reviewv1.ImportTeamsCSVResponse{
+		DryRun:  result.DryRun,
+		Applied: result.Applied,
+		Rows:    int32(result.Rows),
+		Teams:   int32(result.Teams),
+	}
+	for _, e := range result.Errors {
+		out.Errors = append(out.Errors, &reviewv1.RosterRowError{
+			Line:    int32(e.Line),
+			UserId:  e.UserId,
+			Message: e.Message,
+		})
+	}
+	return out
+}
+
+func userToProto(user *api.User) *reviewv1.User {
+	if user == nil {
+		return nil
+	}
+	return &reviewv1.User{
+		UserId:   user.UserId,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+}
+
+func profileToProto(profile *api.UserProfile) *reviewv1.UserProfile {
+	if profile == nil {
+		return nil
+	}
+	return &reviewv1.UserProfile{
+		UserId:   profile.UserId,
+		Username: profile.Username,
+		TeamName: profile.TeamName,
+		Teams:    profile.Teams,
+		IsActive: profile.IsActive,
+		Role:     profile.Role,
+	}
+}
+
+func searchModeFromProto(mode reviewv1.UserSearchMode) api.UserSearchMode {
+	switch mode {
+	case reviewv1.UserSearchMode_USER_SEARCH_MODE_PREFIX:
+		return api.UserSearchModePREFIX
+	case reviewv1.UserSearchMode_USER_SEARCH_MODE_TRIGRAM:
+		return api.UserSearchModeTRIGRAM
+	default:
+		return ""
+	}
+}
+
+func pullRequestToProto(pr *api.PullRequest) *reviewv1.PullRequest {
+	if pr == nil {
+		return nil
+	}
+	return &reviewv1.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            statusToProto(string(pr.Status)),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         timeToProto(pr.CreatedAt),
+		MergedAt:          timeToProto(pr.MergedAt),
+	}
+}
+
+func pullRequestShortToProto(pr api.PullRequestShort) *reviewv1.PullRequestShort {
+	return &reviewv1.PullRequestShort{
+		PullRequestId:   pr.PullRequestId,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorId,
+		Status:          statusToProto(string(pr.Status)),
+	}
+}
+
+// statusToProto принимает статус строкой: у PullRequest и PullRequestShort разные типы статуса
+// с одинаковыми значениями.
+func statusToProto(status string) reviewv1.PullRequestStatus {
+	switch status {
+	case string(api.PullRequestStatusOPEN):
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case string(api.PullRequestStatusMERGED):
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	case string(api.PullRequestStatusCLOSED):
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_CLOSED
+	default:
+		return reviewv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
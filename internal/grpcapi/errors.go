@@ -0,0 +1,69 @@
+package grpcapi
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain — домен google.rpc.ErrorInfo в ошибках сервиса.
+const errorDomain = "review.v1"
+
+// domainError описывает, каким статусом gRPC отвечать на ошибку из types.
+type domainError struct {
+	err     error
+	code    codes.Code
+	reason  api.ErrorResponseErrorCode
+	message string
+}
+
+// domainErrors повторяет соответствие ошибок и кодов ответа REST API: reason совпадает
+// с ErrorResponseErrorCode, поэтому клиенты обоих API разбирают ошибки одинаково.
+var domainErrors = []domainError{
+	{types.ErrNotFound, codes.NotFound, api.NOTFOUND, "resource not found"},
+	{types.ErrAlreadyExists, codes.AlreadyExists, api.TEAMEXISTS, "resource already exists"},
+	{types.ErrPRMerged, codes.FailedPrecondition, api.PRMERGED, "pr is already merged"},
+	{types.ErrPRClosed, codes.FailedPrecondition, api.PRCLOSED, "pr is closed"},
+	{types.ErrNotAssigned, codes.FailedPrecondition, api.NOTASSIGNED, "reviewer is not assigned to this PR"},
+	{types.ErrNoCandidate, codes.FailedPrecondition, api.NOCANDIDATE, "no active replacement candidate in team"},
+	{types.ErrUserInOtherTeam, codes.FailedPrecondition, api.USERINOTHERTEAM, "user already belongs to another team, use MoveUser or AddMember"},
+	{types.ErrTeamHasOpenReviews, codes.FailedPrecondition, api.TEAMHASOPENREVIEWS, "team pull requests still have open reviews"},
+	{types.ErrUnknownIdentity, codes.FailedPrecondition, api.UNKNOWNIDENTITY, "login is not linked to a user"},
+	{types.ErrInvalidInput, codes.InvalidArgument, api.INVALIDINPUT, "invalid input"},
+	{types.ErrTeamRequired, codes.InvalidArgument, api.TEAMREQUIRED, "author belongs to several teams, team_name is required"},
+}
+
+// toStatus переводит ошибку сервиса в статус gRPC. Неизвестные ошибки
+// возвращаются как Internal без подробностей; залогировать их должен вызывающий.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	for _, de := range domainErrors {
+		if !errors.Is(err, de.err) {
+			continue
+		}
+		st, detailsErr := status.New(de.code, de.message).WithDetails(&errdetails.ErrorInfo{
+			Reason: string(de.reason),
+			Domain: errorDomain,
+		})
+		if detailsErr != nil {
+			return status.Error(de.code, de.message)
+		}
+		return st.Err()
+	}
+
+	return status.Error(codes.Internal, "internal error")
+}
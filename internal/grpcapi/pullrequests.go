@@ -0,0 +1,87 @@
+package grpcapi
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	reviewv1 "deplagene/avito-tech-internship/proto/review/v1"
+	"deplagene/avito-tech-internship/types"
+)
+
+type pullRequestServer struct {
+	reviewv1.UnimplementedPullRequestServiceServer
+	prs types.PullRequestService
+}
+
+func (s *pullRequestServer) CreatePullRequest(ctx context.Context, req *reviewv1.CreatePullRequestRequest) (*reviewv1.CreatePullRequestResponse, error) {
+	pr := api.PullRequest{
+		PullRequestId:   req.GetPullRequestId(),
+		PullRequestName: req.GetPullRequestName(),
+		AuthorId:        req.GetAuthorId(),
+	}
+
+	create := s.prs.CreatePullRequest
+	if req.GetDraft() {
+		create = s.prs.CreateDraftPullRequest
+	}
+	created, err := create(ctx, pr, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.CreatePullRequestResponse{Pr: pullRequestToProto(created)}, nil
+}
+
+func (s *pullRequestServer) MergePullRequest(ctx context.Context, req *reviewv1.MergePullRequestRequest) (*reviewv1.MergePullRequestResponse, error) {
+	pr, err := s.prs.MergePullRequest(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.MergePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *pullRequestServer) ClosePullRequest(ctx context.Context, req *reviewv1.ClosePullRequestRequest) (*reviewv1.ClosePullRequestResponse, error) {
+	pr, err := s.prs.ClosePullRequest(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.ClosePullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *pullRequestServer) ReopenPullRequest(ctx context.Context, req *reviewv1.ReopenPullRequestRequest) (*reviewv1.ReopenPullRequestResponse, error) {
+	pr, err := s.prs.ReopenPullRequest(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.ReopenPullRequestResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *pullRequestServer) SetDraft(ctx context.Context, req *reviewv1.SetDraftRequest) (*reviewv1.SetDraftResponse, error) {
+	pr, err := s.prs.SetDraft(ctx, req.GetPullRequestId(), req.GetDraft())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.SetDraftResponse{Pr: pullRequestToProto(pr)}, nil
+}
+
+func (s *pullRequestServer) ReassignReviewer(ctx context.Context, req *reviewv1.ReassignReviewerRequest) (*reviewv1.ReassignReviewerResponse, error) {
+	pr, replacedBy, err := s.prs.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.ReassignReviewerResponse{Pr: pullRequestToProto(pr), ReplacedBy: replacedBy}, nil
+}
+
+func (s *pullRequestServer) GetPullRequestsByReviewer(ctx context.Context, req *reviewv1.GetPullRequestsByReviewerRequest) (*reviewv1.GetPullRequestsByReviewerResponse, error) {
+	prs, err := s.prs.GetPullRequestsByReviewer(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewv1.GetPullRequestsByReviewerResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*reviewv1.PullRequestShort, 0, len(prs)),
+	}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, pullRequestShortToProto(pr))
+	}
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+package grpcapi
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
+	reviewv1 "deplagene/avito-tech-internship/proto/review/v1"
+	"deplagene/avito-tech-internship/types"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// NewServer создает gRPC-сервер с TeamService, UserService и PullRequestService поверх тех же
+// сервисов, что и REST API, а также стандартными health и reflection (для grpcurl).
+func NewServer(
+	teamService types.TeamService,
+	userService types.UserService,
+	prService types.PullRequestService,
+	logger *slog.Logger,
+) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptor(logger)))
+
+	reviewv1.RegisterTeamServiceServer(server, &teamServer{teams: teamService})
+	reviewv1.RegisterUserServiceServer(server, &userServer{users: userService})
+	reviewv1.RegisterPullRequestServiceServer(server, &pullRequestServer{prs: prService})
+
+	healthServer := health.NewServer()
+	for name := range server.GetServiceInfo() {
+		healthServer.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+
+	return server
+}
+
+// unaryInterceptor делает для gRPC то же, что HTTP-мидлвари: кладет в контекст логгер с request_id
+// и пользователем, пишет access-лог и метрики, переводит ошибки сервисов в статусы и ловит панику.
+// request_id и пользователь берутся из метаданных x-request-id и x-user-id.
+func unaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	requestIDKey := strings.ToLower(logging.RequestIDHeader)
+	userKey := strings.ToLower(logging.UserIDHeader)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		start := time.Now()
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestID := first(md.Get(requestIDKey))
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		user := first(md.Get(userKey))
+		if user == "" {
+			user = "anonymous"
+		}
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, requestID))
+
+		reqLogger := logger.With("request_id", requestID, "user", user)
+		ctx = logging.WithLogger(ctx, reqLogger)
+
+		defer func() {
+			if r := recover(); r != nil {
+				reqLogger.ErrorContext(ctx, "grpc handler panicked", "panic", fmt.Sprint(r), "stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, "internal error")
+			}
+
+			code := status.Code(err)
+			metrics.GRPCRequest(info.FullMethod, code.String(), time.Since(start))
+			level := slog.LevelInfo
+			if code == codes.Internal || code == codes.Unknown {
+				level = slog.LevelError
+			}
+			reqLogger.LogAttrs(ctx, level, "grpc request completed",
+				slog.String("method", info.FullMethod),
+				slog.String("code", code.String()),
+				slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			)
+		}()
+
+		resp, err = handler(ctx, req)
+		if err != nil {
+			mapped := toStatus(err)
+			if status.Code(mapped) == codes.Internal {
+				reqLogger.ErrorContext(ctx, "Internal Server Error", "error", err, "method", info.FullMethod)
+			}
+			return nil, mapped
+		}
+		return resp, nil
+	}
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
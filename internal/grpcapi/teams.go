@@ -0,0 +1,107 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	reviewv1 "deplagene/avito-tech-internship/proto/review/v1"
+	"deplagene/avito-tech-internship/types"
+)
+
+type teamServer struct {
+	reviewv1.UnimplementedTeamServiceServer
+	teams types.TeamService
+}
+
+func (s *teamServer) CreateTeam(ctx context.Context, req *reviewv1.CreateTeamRequest) (*reviewv1.CreateTeamResponse, error) {
+	team, err := s.teams.CreateTeam(ctx, teamFromProto(req.GetTeam()))
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.CreateTeamResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *teamServer) GetTeam(ctx context.Context, req *reviewv1.GetTeamRequest) (*reviewv1.GetTeamResponse, error) {
+	team, err := s.teams.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.GetTeamResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *teamServer) ListTeams(ctx context.Context, _ *reviewv1.ListTeamsRequest) (*reviewv1.ListTeamsResponse, error) {
+	names, err := s.teams.ListTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.ListTeamsResponse{TeamNames: names}, nil
+}
+
+func (s *teamServer) AddMember(ctx context.Context, req *reviewv1.AddMemberRequest) (*reviewv1.AddMemberResponse, error) {
+	team, err := s.teams.AddMember(ctx, req.GetTeamName(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.AddMemberResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *teamServer) RemoveMember(ctx context.Context, req *reviewv1.RemoveMemberRequest) (*reviewv1.RemoveMemberResponse, error) {
+	if err := s.teams.RemoveMember(ctx, req.GetTeamName(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &reviewv1.RemoveMemberResponse{}, nil
+}
+
+func (s *teamServer) RenameTeam(ctx context.Context, req *reviewv1.RenameTeamRequest) (*reviewv1.RenameTeamResponse, error) {
+	team, err := s.teams.RenameTeam(ctx, req.GetTeamName(), req.GetNewTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.RenameTeamResponse{Team: teamToProto(team)}, nil
+}
+
+func (s *teamServer) DeleteTeam(ctx context.Context, req *reviewv1.DeleteTeamRequest) (*reviewv1.DeleteTeamResponse, error) {
+	if err := s.teams.DeleteTeam(ctx, req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	return &reviewv1.DeleteTeamResponse{}, nil
+}
+
+func (s *teamServer) GetTeamTree(ctx context.Context, req *reviewv1.GetTeamTreeRequest) (*reviewv1.GetTeamTreeResponse, error) {
+	node, err := s.teams.GetTeamTree(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.GetTeamTreeResponse{Team: teamNodeToProto(node)}, nil
+}
+
+func (s *teamServer) SetParentTeam(ctx context.Context, req *reviewv1.SetParentTeamRequest) (*reviewv1.SetParentTeamResponse, error) {
+	node, err := s.teams.SetParentTeam(ctx, req.GetTeamName(), req.ParentTeam)
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.SetParentTeamResponse{Team: teamNodeToProto(node)}, nil
+}
+
+func (s *teamServer) UpdateTeamSettings(ctx context.Context, req *reviewv1.UpdateTeamSettingsRequest) (*reviewv1.UpdateTeamSettingsResponse, error) {
+	node, err := s.teams.UpdateTeamSettings(ctx, req.GetTeamName(), settingsFromProto(req.GetSettings()))
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.UpdateTeamSettingsResponse{Team: teamNodeToProto(node)}, nil
+}
+
+func (s *teamServer) ImportTeamsCSV(ctx context.Context, req *reviewv1.ImportTeamsCSVRequest) (*reviewv1.ImportTeamsCSVResponse, error) {
+	result, err := s.teams.ImportTeamsCSV(ctx, bytes.NewReader(req.GetCsv()), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+	return importResultToProto(result), nil
+}
+
+func (s *teamServer) ExportTeamsCSV(ctx context.Context, _ *reviewv1.ExportTeamsCSVRequest) (*reviewv1.ExportTeamsCSVResponse, error) {
+	csv, err := s.teams.ExportTeamsCSV(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.ExportTeamsCSVResponse{Csv: csv}, nil
+}
@@ -0,0 +1,61 @@
+package grpcapi
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	reviewv1 "deplagene/avito-tech-internship/proto/review/v1"
+	"deplagene/avito-tech-internship/types"
+)
+
+type userServer struct {
+	reviewv1.UnimplementedUserServiceServer
+	users types.UserService
+}
+
+func (s *userServer) SetUserIsActive(ctx context.Context, req *reviewv1.SetUserIsActiveRequest) (*reviewv1.SetUserIsActiveResponse, error) {
+	user, err := s.users.SetUserIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.SetUserIsActiveResponse{User: userToProto(user)}, nil
+}
+
+func (s *userServer) MoveUser(ctx context.Context, req *reviewv1.MoveUserRequest) (*reviewv1.MoveUserResponse, error) {
+	user, err := s.users.MoveUser(ctx, req.GetUserId(), req.GetTeamName())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.MoveUserResponse{User: userToProto(user)}, nil
+}
+
+func (s *userServer) GetUser(ctx context.Context, req *reviewv1.GetUserRequest) (*reviewv1.GetUserResponse, error) {
+	profile, err := s.users.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &reviewv1.GetUserResponse{User: profileToProto(profile)}, nil
+}
+
+func (s *userServer) ListUsers(ctx context.Context, req *reviewv1.ListUsersRequest) (*reviewv1.ListUsersResponse, error) {
+	page, err := s.users.ListUsers(ctx, api.GetUsersListParams{
+		TeamName: req.TeamName,
+		IsActive: req.IsActive,
+		Role:     req.Role,
+		Query:    req.GetQuery(),
+		Search:   searchModeFromProto(req.GetSearch()),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reviewv1.ListUsersResponse{Users: make([]*reviewv1.UserProfile, 0, len(page.Users))}
+	for i := range page.Users {
+		resp.Users = append(resp.Users, profileToProto(&page.Users[i]))
+	}
+	if page.NextCursor != nil {
+		resp.NextCursor = *page.NextCursor
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+)
+
+type afterCommitKey struct{}
+
+type afterCommit struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// WithAfterCommit готовит контекст транзакции, внутри которой вызываются методы с чужой транзакцией
+// (…Tx, ReassignOpenReviews). Обновления счетчиков, отложенные ими через AfterCommit, копятся
+// и применяются вызовом flush — владелец транзакции делает его только после успешного коммита,
+// поэтому откаченные изменения в метрики не попадают.
+func WithAfterCommit(ctx context.Context) (context.Context, func()) {
+	pending := &afterCommit{}
+	flush := func() {
+		pending.mu.Lock()
+		fns := pending.fns
+		pending.fns = nil
+		pending.mu.Unlock()
+
+		for _, fn := range fns {
+			fn()
+		}
+	}
+	return context.WithValue(ctx, afterCommitKey{}, pending), flush
+}
+
+// AfterCommit откладывает обновление счетчиков до коммита транзакции, подготовленной WithAfterCommit.
+// Если контекст не подготовлен, транзакцией никто не управляет снаружи и fn выполняется сразу.
+func AfterCommit(ctx context.Context, fn func()) {
+	pending, ok := ctx.Value(afterCommitKey{}).(*afterCommit)
+	if !ok {
+		fn()
+		return
+	}
+	pending.mu.Lock()
+	pending.fns = append(pending.fns, fn)
+	pending.mu.Unlock()
+}
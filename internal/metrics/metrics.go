@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "review_service"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, chi route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method and chi route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	apiErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_errors_total",
+		Help:      "Error responses by ErrorResponseErrorCode.",
+	}, []string{"code"})
+
+	reviewerAssignments = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reviewer_assignments_total",
+		Help:      "Reviewers assigned to pull requests, on creation and as replacements, by selection strategy.",
+	}, []string{"strategy"})
+
+	reviewerReassignments = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reviewer_reassignments_total",
+		Help:      "Reviewers removed from open pull requests (explicit reassign, leaving a team, deactivation).",
+	})
+
+	noCandidate = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_total",
+		Help:      "Reassignments for which no replacement reviewer was found.",
+	})
+
+	merges = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_request_merges_total",
+		Help:      "Pull requests merged.",
+	})
+
+	webhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "Outbound webhook delivery attempts by result (delivered, failed, dead_letter).",
+	}, []string{"result"})
+
+	outboxPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "outbox_published_total",
+		Help:      "Domain events published from the outbox to the message broker.",
+	})
+
+	notifications = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "notifications_total",
+		Help:      "Reviewer notifications by channel and result (sent, skipped, failed, dropped).",
+	}, []string{"channel", "result"})
+
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "gRPC requests by full method name and status code.",
+	}, []string{"method", "code"})
+
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "gRPC request latency by full method name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+// Middleware считает запросы и их длительность по шаблону маршрута chi,
+// чтобы значения path-параметров не раздували число временных рядов.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		httpRequests.WithLabelValues(r.Method, route, strconv.Itoa(status)).Inc()
+		httpDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// APIError учитывает ответ с ошибкой по ее коду.
+func APIError(code string) {
+	apiErrors.WithLabelValues(code).Inc()
+}
+
+// ReviewersAssigned учитывает назначение n ревьюверов по стратегии strategy.
+func ReviewersAssigned(strategy string, n int) {
+	if n > 0 {
+		reviewerAssignments.WithLabelValues(strategy).Add(float64(n))
+	}
+}
+
+// ReviewerReassigned учитывает снятие ревьювера с открытого PR.
+func ReviewerReassigned() {
+	reviewerReassignments.Inc()
+}
+
+// NoCandidate учитывает переназначение, для которого не нашлось замены.
+func NoCandidate() {
+	noCandidate.Inc()
+}
+
+// Merged учитывает merge PR.
+func Merged() {
+	merges.Inc()
+}
+
+// WebhookDelivery учитывает попытку доставки исходящего вебхука с результатом result.
+func WebhookDelivery(result string) {
+	webhookDeliveries.WithLabelValues(result).Inc()
+}
+
+// OutboxPublished учитывает n событий, опубликованных из outbox.
+func OutboxPublished(n int) {
+	if n > 0 {
+		outboxPublished.Add(float64(n))
+	}
+}
+
+// Notification учитывает обработку уведомления канала channel с результатом result.
+func Notification(channel, result string) {
+	notifications.WithLabelValues(channel, result).Inc()
+}
+
+// GRPCRequest учитывает gRPC-запрос к методу method, завершившийся с кодом code.
+func GRPCRequest(method, code string, duration time.Duration) {
+	grpcRequests.WithLabelValues(method, code).Inc()
+	grpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
@@ -0,0 +1,234 @@
+package notify
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ChannelChat — канал уведомлений в Slack-совместимые incoming webhooks (Slack, Mattermost, Rocket.Chat).
+const ChannelChat = "chat"
+
+// Сообщения по умолчанию. Ссылка в формате <url|текст> понятна и Slack, и Mattermost.
+const (
+	defaultAssignedTemplate = `{{escape .ReviewerName}}, you were assigned to review ` +
+		`{{if .Link}}<{{.Link}}|{{escape .PullRequestName}}>{{else}}{{escape .PullRequestName}}{{end}} ` +
+		`by {{escape .AuthorName}}`
+	defaultReassignedTemplate = `{{escape .ReviewerName}}, you were assigned to review ` +
+		`{{if .Link}}<{{.Link}}|{{escape .PullRequestName}}>{{else}}{{escape .PullRequestName}}{{end}} ` +
+		`by {{escape .AuthorName}} instead of {{escape .PreviousReviewerName}}`
+)
+
+// chatEscaper экранирует символы разметки Slack и Mattermost: иначе "<" или ">" в названии PR
+// ломают ссылку, а "<!channel>" упоминает весь канал.
+var chatEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// chatFuncs доступны и в шаблонах из настроек: {{escape .PullRequestName}}.
+var chatFuncs = template.FuncMap{"escape": chatEscaper.Replace}
+
+// ChatConfig — настройки чат-уведомлений, которые хранятся в YAML-файле.
+//
+//	webhook_url: https://hooks.slack.com/services/T000/B000/XXX
+//	username: review-bot
+//	templates:
+//	  assigned: "{{escape .ReviewerName}}, please review {{escape .PullRequestName}}"
+//	teams:
+//	  backend:
+//	    channel: "#backend-review"
+//	users:
+//	  u2:
+//	    channel: "@bob"
+//	  u3:
+//	    webhook_url: https://hooks.slack.com/services/T000/B000/YYY
+//
+// Назначение публикуется в канал команды PR и, если для ревьювера задан адрес, ему в личные
+// сообщения. Команды и пользователи без записи в файле пропускаются.
+type ChatConfig struct {
+	// WebhookURL используется для всех получателей, у которых не задан свой webhook_url.
+	WebhookURL string            `yaml:"webhook_url"`
+	Username   string            `yaml:"username"`
+	Templates  ChatTemplates     `yaml:"templates"`
+	Teams      map[string]Target `yaml:"teams"`
+	Users      map[string]Target `yaml:"users"`
+}
+
+// ChatTemplates — шаблоны text/template сообщений по поводам уведомления; данные — Notification.
+type ChatTemplates struct {
+	Assigned   string `yaml:"assigned"`
+	Reassigned string `yaml:"reassigned"`
+}
+
+// Target — куда публиковать сообщение. Channel переопределяет канал вебхука, например "#team"
+// или "@user"; Slack учитывает его только у legacy-вебхуков, Mattermost — всегда.
+type Target struct {
+	WebhookURL string `yaml:"webhook_url"`
+	Channel    string `yaml:"channel"`
+}
+
+// LoadChatConfig читает и проверяет файл настроек чат-уведомлений.
+func LoadChatConfig(path string) (*ChatConfig, error) {
+	const op = "notify.LoadChatConfig"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var cfg ChatConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %s: %w", op, path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", op, path, err)
+	}
+	return &cfg, nil
+}
+
+// validate проверяет, что у каждого получателя есть адрес вебхука.
+func (c *ChatConfig) validate() error {
+	if c.WebhookURL != "" {
+		if err := validateWebhookURL(c.WebhookURL); err != nil {
+			return err
+		}
+	}
+
+	for kind, targets := range map[string]map[string]Target{"team": c.Teams, "user": c.Users} {
+		for name, target := range targets {
+			if target.WebhookURL == "" {
+				if c.WebhookURL == "" {
+					return fmt.Errorf("%s %s: webhook_url is required when there is no default webhook_url", kind, name)
+				}
+				continue
+			}
+			if err := validateWebhookURL(target.WebhookURL); err != nil {
+				return fmt.Errorf("%s %s: %w", kind, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook_url must be an absolute http(s) url")
+	}
+	return nil
+}
+
+// chatMessage — тело запроса к incoming webhook.
+type chatMessage struct {
+	Text     string `json:"text"`
+	Channel  string `json:"channel,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// ChatSender публикует уведомления в Slack-совместимые incoming webhooks.
+type ChatSender struct {
+	cfg       ChatConfig
+	templates map[Kind]*template.Template
+	client    *http.Client
+}
+
+// NewChatSender разбирает шаблоны сообщений; незаданные заменяются шаблонами по умолчанию.
+func NewChatSender(cfg ChatConfig) (*ChatSender, error) {
+	const op = "notify.NewChatSender"
+
+	sources := map[Kind]string{
+		KindAssigned:   cmp.Or(cfg.Templates.Assigned, defaultAssignedTemplate),
+		KindReassigned: cmp.Or(cfg.Templates.Reassigned, defaultReassignedTemplate),
+	}
+	templates := make(map[Kind]*template.Template, len(sources))
+	for kind, source := range sources {
+		tmpl, err := template.New(string(kind)).Funcs(chatFuncs).Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s template: %w", op, kind, err)
+		}
+		templates[kind] = tmpl
+	}
+
+	return &ChatSender{
+		cfg:       cfg,
+		templates: templates,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (s *ChatSender) Channel() string {
+	return ChannelChat
+}
+
+// Send публикует сообщение в канал команды и в личные сообщения ревьюверу. Если одна из
+// публикаций не удалась, повтор отправит обе: доставка at-least-once.
+func (s *ChatSender) Send(ctx context.Context, n Notification) error {
+	var text strings.Builder
+	if err := s.templates[n.Kind].Execute(&text, n); err != nil {
+		return fmt.Errorf("render %s message: %w", n.Kind, err)
+	}
+
+	teamTarget, toTeam := s.cfg.Teams[n.TeamName]
+	userTarget, toUser := s.cfg.Users[n.ReviewerID]
+	if !toTeam && !toUser {
+		return errNoRecipient
+	}
+
+	var errs []error
+	if toTeam {
+		if err := s.post(ctx, teamTarget, text.String()); err != nil {
+			errs = append(errs, fmt.Errorf("team %s: %w", n.TeamName, err))
+		}
+	}
+	if toUser {
+		if err := s.post(ctx, userTarget, text.String()); err != nil {
+			errs = append(errs, fmt.Errorf("user %s: %w", n.ReviewerID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post выполняет один POST к вебхуку. Успехом считается любой ответ 2xx.
+func (s *ChatSender) post(ctx context.Context, target Target, text string) error {
+	body, err := json.Marshal(chatMessage{Text: text, Channel: target.Channel, Username: s.cfg.Username})
+	if err != nil {
+		return err
+	}
+
+	webhookURL := cmp.Or(target.WebhookURL, s.cfg.WebhookURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// В тексте ошибки url.Error есть адрес вебхука, а он сам по себе секрет
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// chatServer — incoming webhook, который запоминает сообщения по пути запроса
+// и отвечает статусом из statuses (по умолчанию 200).
+type chatServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	messages map[string][]chatMessage
+	statuses map[string]int
+}
+
+func newChatServer(t *testing.T, statuses map[string]int) *chatServer {
+	t.Helper()
+
+	s := &chatServer{messages: make(map[string][]chatMessage), statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg chatMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("decode chat message: %v", err)
+		}
+		s.mu.Lock()
+		s.messages[r.URL.Path] = append(s.messages[r.URL.Path], msg)
+		s.mu.Unlock()
+
+		if status, ok := s.statuses[r.URL.Path]; ok {
+			w.WriteHeader(status)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *chatServer) received(path string) []chatMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages[path]
+}
+
+func newTestChatSender(t *testing.T, cfg ChatConfig) *ChatSender {
+	t.Helper()
+
+	if err := cfg.validate(); err != nil {
+		t.Fatalf("validate config: %v", err)
+	}
+	sender, err := NewChatSender(cfg)
+	if err != nil {
+		t.Fatalf("new chat sender: %v", err)
+	}
+	return sender
+}
+
+func testNotification() Notification {
+	return Notification{
+		Kind:            KindAssigned,
+		PullRequestID:   "pr-1",
+		PullRequestName: "Add search",
+		TeamName:        "backend",
+		AuthorID:        "u1",
+		AuthorName:      "alice",
+		ReviewerID:      "u2",
+		ReviewerName:    "bob",
+	}
+}
+
+func TestChatSenderTeamAndDirectMessage(t *testing.T) {
+	server := newChatServer(t, nil)
+	sender := newTestChatSender(t, ChatConfig{
+		WebhookURL: server.URL + "/default",
+		Username:   "review-bot",
+		Teams:      map[string]Target{"backend": {Channel: "#backend-review"}},
+		Users:      map[string]Target{"u2": {WebhookURL: server.URL + "/dm", Channel: "@bob"}},
+	})
+
+	n := testNotification()
+	n.Link = "https://github.com/pr-1"
+	if err := sender.Send(context.Background(), n); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	want := "bob, you were assigned to review <https://github.com/pr-1|Add search> by alice"
+	for path, channel := range map[string]string{"/default": "#backend-review", "/dm": "@bob"} {
+		messages := server.received(path)
+		if len(messages) != 1 {
+			t.Fatalf("%s: got %d messages, want 1", path, len(messages))
+		}
+		if messages[0].Text != want {
+			t.Errorf("%s: text %q, want %q", path, messages[0].Text, want)
+		}
+		if messages[0].Channel != channel {
+			t.Errorf("%s: channel %q, want %q", path, messages[0].Channel, channel)
+		}
+		if messages[0].Username != "review-bot" {
+			t.Errorf("%s: username %q, want review-bot", path, messages[0].Username)
+		}
+	}
+}
+
+// Ошибка одного получателя не мешает отправке другому, но возвращается, чтобы Worker повторил попытку.
+func TestChatSenderServerError(t *testing.T) {
+	server := newChatServer(t, map[string]int{"/dm": http.StatusBadGateway})
+	sender := newTestChatSender(t, ChatConfig{
+		Teams: map[string]Target{"backend": {WebhookURL: server.URL + "/team"}},
+		Users: map[string]Target{"u2": {WebhookURL: server.URL + "/dm"}},
+	})
+
+	err := sender.Send(context.Background(), testNotification())
+	if err == nil {
+		t.Fatal("send succeeded, want error for 502 response")
+	}
+	if !strings.Contains(err.Error(), "user u2") || !strings.Contains(err.Error(), "unexpected status 502") {
+		t.Errorf("error %q does not name the failed recipient and status", err)
+	}
+	if strings.Contains(err.Error(), server.URL) {
+		t.Errorf("error %q leaks the webhook url", err)
+	}
+	if errors.Is(err, errNoRecipient) {
+		t.Error("server error reported as errNoRecipient")
+	}
+	if got := len(server.received("/team")); got != 1 {
+		t.Errorf("team channel got %d messages, want 1", got)
+	}
+}
+
+func TestChatSenderNoRecipient(t *testing.T) {
+	server := newChatServer(t, nil)
+	sender := newTestChatSender(t, ChatConfig{
+		WebhookURL: server.URL + "/default",
+		Teams:      map[string]Target{"frontend": {}},
+		Users:      map[string]Target{"u3": {}},
+	})
+
+	if err := sender.Send(context.Background(), testNotification()); !errors.Is(err, errNoRecipient) {
+		t.Fatalf("send: got %v, want errNoRecipient", err)
+	}
+	if got := len(server.received("/default")); got != 0 {
+		t.Errorf("got %d messages, want none", got)
+	}
+}
+
+func TestChatSenderEscapesMarkup(t *testing.T) {
+	server := newChatServer(t, nil)
+	sender := newTestChatSender(t, ChatConfig{
+		Teams: map[string]Target{"backend": {WebhookURL: server.URL + "/team"}},
+	})
+
+	n := testNotification()
+	n.Kind = KindReassigned
+	n.PullRequestName = "Fix <b> & <i> tags"
+	n.AuthorName = "<!channel>"
+	n.ReviewerName = "a>b"
+	n.PreviousReviewerName = "c&d"
+	n.Link = "https://github.com/pr-1?a=1&b=2"
+	if err := sender.Send(context.Background(), n); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	messages := server.received("/team")
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+	want := "a&gt;b, you were assigned to review <https://github.com/pr-1?a=1&b=2|Fix &lt;b&gt; &amp; &lt;i&gt; tags> " +
+		"by &lt;!channel&gt; instead of c&amp;d"
+	if messages[0].Text != want {
+		t.Errorf("text %q, want %q", messages[0].Text, want)
+	}
+}
@@ -0,0 +1,226 @@
+package notify
+
+import (
+	"cmp"
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// channelDigest — метка дайджеста в метрике уведомлений.
+const channelDigest = "digest"
+
+// Digest — данные шаблона ежедневного дайджеста.
+type Digest struct {
+	UserID   string
+	Username string
+	Date     time.Time
+	// Reviews — открытые ревью пользователя, самые старые первыми.
+	Reviews []DigestReview
+}
+
+// DigestReview — открытое ревью в дайджесте.
+type DigestReview struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	AuthorName      string
+	CreatedAt       time.Time
+	// Age — сколько PR ждет ревью; в шаблоне печатается функцией age.
+	Age  time.Duration
+	Link string
+}
+
+// DigestConfig задает время рассылки дайджеста.
+type DigestConfig struct {
+	// At — время рассылки в формате "15:04".
+	At       string
+	Location *time.Location
+	// LinkTemplate — шаблон ссылки на PR, тот же, что у уведомлений о назначении.
+	LinkTemplate string
+}
+
+// Digester раз в день отправляет каждому подписанному пользователю письмо с его открытыми ревью.
+// Отправленный дайджест отмечается в notification_settings, поэтому при нескольких экземплярах
+// сервиса и после перезапуска пользователь получает не больше одного письма в день.
+type Digester struct {
+	repo     *Repository
+	prRepo   types.PullRequestRepository
+	userRepo types.UserRepository
+	db       *pgxpool.Pool
+	email    *EmailSender
+	at       time.Duration
+	location *time.Location
+	link     *template.Template
+	logger   *slog.Logger
+}
+
+func NewDigester(
+	repo *Repository,
+	prRepo types.PullRequestRepository,
+	userRepo types.UserRepository,
+	db *pgxpool.Pool,
+	email *EmailSender,
+	cfg DigestConfig,
+	logger *slog.Logger,
+) (*Digester, error) {
+	const op = "notify.NewDigester"
+
+	at, err := time.Parse("15:04", cmp.Or(cfg.At, "09:00"))
+	if err != nil {
+		return nil, fmt.Errorf("%s: digest time must be HH:MM: %w", op, err)
+	}
+	link, err := template.New("link").Parse(cfg.LinkTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: link template: %w", op, err)
+	}
+
+	return &Digester{
+		repo:     repo,
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		db:       db,
+		email:    email,
+		at:       time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute,
+		location: cmp.Or(cfg.Location, time.UTC),
+		link:     link,
+		logger:   logger,
+	}, nil
+}
+
+// Run раз в минуту проверяет, наступило ли время рассылки, и отправляет дайджесты,
+// которые за сегодня еще не ушли.
+func (d *Digester) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		now := time.Now().In(d.location)
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, d.location)
+		if !now.Before(today.Add(d.at)) {
+			d.sendDue(ctx, now)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDue отправляет дайджесты за день now по одному пользователю в транзакции. Если письмо
+// не ушло, пользователь пропускается до следующей проверки.
+func (d *Digester) sendDue(ctx context.Context, now time.Time) {
+	// DATE в БД не знает о часовом поясе, поэтому день передается как полночь UTC
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var failed []string
+
+	for ctx.Err() == nil {
+		var rcpt *recipient
+		result := resultSkipped
+		err := pgx.BeginFunc(ctx, d.db, func(tx pgx.Tx) error {
+			var err error
+			rcpt, err = d.repo.ClaimDigest(ctx, tx, day, failed)
+			if err != nil || rcpt == nil {
+				return err
+			}
+
+			digest, err := d.build(ctx, tx, *rcpt, now)
+			if err != nil {
+				return err
+			}
+			if len(digest.Reviews) > 0 {
+				if err := d.email.send(ctx, rcpt.Email, templateDigest, digest); err != nil {
+					return err
+				}
+				result = resultSent
+			}
+			return d.repo.MarkDigestSent(ctx, tx, rcpt.UserID, day)
+		})
+		if rcpt == nil && err == nil {
+			return
+		}
+		if err != nil {
+			if rcpt == nil {
+				if ctx.Err() == nil {
+					d.logger.ErrorContext(ctx, "failed to claim digest recipient", utils.Err(err))
+				}
+				return
+			}
+			result = resultFailed
+			failed = append(failed, rcpt.UserID)
+			d.logger.WarnContext(ctx, "failed to send review digest", "user_id", rcpt.UserID, utils.Err(err))
+		}
+		metrics.Notification(channelDigest, result)
+	}
+}
+
+// build собирает открытые ревью пользователя, самые старые первыми.
+func (d *Digester) build(ctx context.Context, tx pgx.Tx, rcpt recipient, now time.Time) (*Digest, error) {
+	prs, err := d.prRepo.GetByReviewer(ctx, tx, rcpt.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := &Digest{UserID: rcpt.UserID, Username: rcpt.Username, Date: now}
+	authors := make(map[string]string)
+	for _, short := range prs {
+		if short.Status != api.PullRequestShortStatusOPEN {
+			continue
+		}
+
+		pr, err := d.prRepo.GetByID(ctx, tx, short.PullRequestId)
+		if err != nil {
+			return nil, err
+		}
+		if pr == nil || pr.CreatedAt == nil {
+			continue
+		}
+
+		authorName, ok := authors[short.AuthorId]
+		if !ok {
+			author, err := d.userRepo.GetByID(ctx, tx, short.AuthorId)
+			if err != nil {
+				return nil, err
+			}
+			authorName = short.AuthorId
+			if author != nil && author.Username != "" {
+				authorName = author.Username
+			}
+			authors[short.AuthorId] = authorName
+		}
+
+		review := DigestReview{
+			PullRequestID:   short.PullRequestId,
+			PullRequestName: short.PullRequestName,
+			AuthorID:        short.AuthorId,
+			AuthorName:      authorName,
+			CreatedAt:       *pr.CreatedAt,
+			Age:             now.Sub(*pr.CreatedAt),
+		}
+		var link strings.Builder
+		if err := d.link.Execute(&link, review); err != nil {
+			return nil, fmt.Errorf("render link: %w", err)
+		}
+		review.Link = link.String()
+
+		digest.Reviews = append(digest.Reviews, review)
+	}
+
+	slices.SortFunc(digest.Reviews, func(a, b DigestReview) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return digest, nil
+}
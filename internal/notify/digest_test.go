@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestDigestOldestFirstOncePerDay проверяет порядок ревью в дайджесте и то, что повторная
+// проверка в тот же день писем не отправляет.
+func TestDigestOldestFirstOncePerDay(t *testing.T) {
+	f := newEmailFixture(t)
+	server := newSMTPServer(t)
+	sender := newTestEmailSender(t, server, f.repo, f.pool)
+	ctx := context.Background()
+
+	// PR создаются не в порядке возраста, чтобы сортировка не совпала с порядком вставки
+	ages := []struct {
+		id  string
+		age time.Duration
+	}{
+		{id: "pr-new", age: time.Hour},
+		{id: "pr-old", age: 72 * time.Hour},
+		{id: "pr-mid", age: 24 * time.Hour},
+	}
+	for _, pr := range ages {
+		if _, err := f.prService.CreatePullRequest(ctx, api.PullRequest{
+			PullRequestId:   pr.id,
+			PullRequestName: "name " + pr.id,
+			AuthorId:        "u1",
+		}, ""); err != nil {
+			t.Fatalf("create %s: %v", pr.id, err)
+		}
+		if _, err := f.pool.Exec(ctx, `UPDATE pull_requests SET created_at = $2 WHERE pull_request_id = $1`,
+			pr.id, time.Now().Add(-pr.age)); err != nil {
+			t.Fatalf("backdate %s: %v", pr.id, err)
+		}
+	}
+
+	digester, err := NewDigester(f.repo, f.prRepo, f.userRepo, f.pool, sender, DigestConfig{}, discardLogger())
+	if err != nil {
+		t.Fatalf("new digester: %v", err)
+	}
+
+	now := time.Now()
+	digester.sendDue(ctx, now)
+
+	messages := server.received()
+	if len(messages) != 1 {
+		t.Fatalf("got %d digests, want 1 (only bob is subscribed)", len(messages))
+	}
+	digest := messages[0]
+	if digest.To != "bob@example.com" {
+		t.Errorf("digest sent to %s, want bob@example.com", digest.To)
+	}
+	if digest.Subject != "3 open review(s) waiting for you" {
+		t.Errorf("subject %q", digest.Subject)
+	}
+	for _, part := range []struct{ name, body string }{{"text", digest.Text}, {"html", digest.HTML}} {
+		old, mid, fresh := strings.Index(part.body, "pr-old"), strings.Index(part.body, "pr-mid"), strings.Index(part.body, "pr-new")
+		if old < 0 || mid < 0 || fresh < 0 || !(old < mid && mid < fresh) {
+			t.Errorf("%s part is not ordered oldest first: %q", part.name, part.body)
+		}
+	}
+	if !strings.Contains(digest.Text, "waiting 3d 0h") {
+		t.Errorf("text part lacks the age of the oldest review: %q", digest.Text)
+	}
+
+	digester.sendDue(ctx, now)
+	if got := len(server.received()); got != 1 {
+		t.Errorf("second check on the same day sent %d more digests", got-1)
+	}
+}
@@ -0,0 +1,299 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"deplagene/avito-tech-internship/cmd/api"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ChannelEmail — канал уведомлений по email.
+const ChannelEmail = "email"
+
+// templateDigest — имя шаблонов ежедневного дайджеста.
+const templateDigest = "digest"
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// SMTPConfig задает SMTP-сервер и отправителя писем.
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	// From — адрес отправителя, можно с именем: "Review Bot <review@example.com>".
+	From string
+	// TemplateDir — каталог с шаблонами, заменяющими встроенные; файл, которого в нем нет,
+	// берется из встроенных шаблонов.
+	TemplateDir string
+}
+
+// mailTemplate — тема, текстовая и HTML-версии одного вида письма. Тема задается
+// блоком {{define "subject"}} в текстовом шаблоне.
+type mailTemplate struct {
+	text *template.Template
+	html *htmltemplate.Template
+}
+
+// EmailSender отправляет письма о назначениях и ежедневные дайджесты.
+// Адрес и включенные виды писем берутся из настроек получателя.
+type EmailSender struct {
+	cfg       SMTPConfig
+	from      *mail.Address
+	templates map[string]mailTemplate
+	repo      *Repository
+	db        *pgxpool.Pool
+}
+
+func NewEmailSender(cfg SMTPConfig, repo *Repository, db *pgxpool.Pool) (*EmailSender, error) {
+	const op = "notify.NewEmailSender"
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid sender address: %w", op, err)
+	}
+
+	templates := make(map[string]mailTemplate, 3)
+	for _, name := range []string{string(KindAssigned), string(KindReassigned), templateDigest} {
+		tmpl, err := loadMailTemplate(cfg.TemplateDir, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		templates[name] = tmpl
+	}
+
+	return &EmailSender{
+		cfg:       cfg,
+		from:      from,
+		templates: templates,
+		repo:      repo,
+		db:        db,
+	}, nil
+}
+
+func (s *EmailSender) Channel() string {
+	return ChannelEmail
+}
+
+// Send отправляет письмо о назначении, если у ревьювера указан адрес и этот вид писем включен.
+func (s *EmailSender) Send(ctx context.Context, n Notification) error {
+	var settings *api.NotificationSettings
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		var err error
+		settings, err = s.repo.GetSettings(ctx, tx, n.ReviewerID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if settings == nil || settings.Email == "" {
+		return errNoRecipient
+	}
+	if (n.Kind == KindAssigned && !settings.Assigned) || (n.Kind == KindReassigned && !settings.Reassigned) {
+		return errNoRecipient
+	}
+
+	return s.send(ctx, settings.Email, string(n.Kind), n)
+}
+
+// send отрисовывает шаблон name с данными data и отправляет письмо на адрес to.
+func (s *EmailSender) send(ctx context.Context, to, name string, data any) error {
+	tmpl := s.templates[name]
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return fmt.Errorf("render %s subject: %w", name, err)
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return fmt.Errorf("render %s text: %w", name, err)
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return fmt.Errorf("render %s html: %w", name, err)
+	}
+
+	msg, err := s.buildMessage(to, strings.TrimSpace(subject.String()), text.Bytes(), html.Bytes())
+	if err != nil {
+		return err
+	}
+	return s.deliver(ctx, to, msg)
+}
+
+// buildMessage собирает письмо multipart/alternative из текстовой и HTML-версий.
+func (s *EmailSender) buildMessage(to, subject string, text, html []byte) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=utf-8", text},
+		{"text/html; charset=utf-8", html},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	domain := s.from.Address[strings.LastIndex(s.from.Address, "@")+1:]
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Message-ID: <%s@%s>\r\n", uuid.NewString(), domain)
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", parts.Boundary())
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+// deliver передает письмо SMTP-серверу. STARTTLS используется, если сервер его поддерживает;
+// логин и пароль net/smtp отправит только по TLS или на localhost.
+func (s *EmailSender) deliver(ctx context.Context, to string, msg []byte) error {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.cfg.Host, s.cfg.Port))
+	if err != nil {
+		return fmt.Errorf("connect to smtp: %w", err)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Minute)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.cfg.Host}); err != nil {
+			return fmt.Errorf("smtp starttls: %w", err)
+		}
+	}
+	if s.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("smtp mail from: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("smtp rcpt to: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp data: %w", err)
+	}
+	return client.Quit()
+}
+
+// loadMailTemplate разбирает шаблоны name.txt и name.html из dir или из встроенных.
+func loadMailTemplate(dir, name string) (mailTemplate, error) {
+	funcs := map[string]any{"age": formatAge}
+
+	textSource, err := readTemplate(dir, name+".txt")
+	if err != nil {
+		return mailTemplate{}, err
+	}
+	text, err := template.New(name).Funcs(funcs).Parse(textSource)
+	if err != nil {
+		return mailTemplate{}, fmt.Errorf("%s.txt: %w", name, err)
+	}
+	if text.Lookup("subject") == nil {
+		return mailTemplate{}, fmt.Errorf("%s.txt: subject block is required", name)
+	}
+
+	htmlSource, err := readTemplate(dir, name+".html")
+	if err != nil {
+		return mailTemplate{}, err
+	}
+	html, err := htmltemplate.New(name).Funcs(funcs).Parse(htmlSource)
+	if err != nil {
+		return mailTemplate{}, fmt.Errorf("%s.html: %w", name, err)
+	}
+
+	return mailTemplate{text: text, html: html}, nil
+}
+
+// readTemplate читает файл шаблона из dir, а если его там нет — из встроенных шаблонов.
+func readTemplate(dir, file string) (string, error) {
+	if dir != "" {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	data, err := defaultTemplates.ReadFile("templates/" + file)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// formatAge печатает возраст ревью с точностью до минут: "3d 4h", "5h 12m", "7m".
+func formatAge(d time.Duration) string {
+	d = d.Truncate(time.Minute)
+	days := d / (24 * time.Hour)
+	hours := (d % (24 * time.Hour)) / time.Hour
+	minutes := (d % time.Hour) / time.Minute
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
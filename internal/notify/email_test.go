@@ -0,0 +1,323 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/testdb"
+	"deplagene/avito-tech-internship/internal/user"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// smtpServer — минимальный SMTP-сервер без STARTTLS и AUTH, который запоминает принятые письма.
+type smtpServer struct {
+	host, port string
+
+	mu       sync.Mutex
+	messages []receivedMail
+}
+
+// receivedMail — принятое письмо, разобранное на тему и части.
+type receivedMail struct {
+	From    string
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &smtpServer{}
+	s.host, s.port, _ = net.SplitHostPort(ln.Addr().String())
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(t, conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP")
+
+	var from, to string
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 localhost")
+		case "MAIL":
+			from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			to = strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			received, err := parseMail(data)
+			if err != nil {
+				t.Errorf("parse mail: %v", err)
+			}
+			received.From, received.To = from, to
+			s.mu.Lock()
+			s.messages = append(s.messages, received)
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK")
+		case "RSET", "NOOP":
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func (s *smtpServer) received() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail(nil), s.messages...)
+}
+
+// parseMail разбирает письмо multipart/alternative; части quoted-printable декодирует multipart.Reader.
+func parseMail(data []byte) (receivedMail, error) {
+	msg, err := mail.ReadMessage(bufio.NewReader(bytes.NewReader(data)))
+	if err != nil {
+		return receivedMail{}, err
+	}
+
+	var received receivedMail
+	if received.Subject, err = new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); err != nil {
+		return receivedMail{}, err
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return receivedMail{}, err
+	}
+	if mediaType != "multipart/alternative" {
+		return receivedMail{}, errors.New("unexpected content type " + mediaType)
+	}
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return receivedMail{}, err
+		}
+		body, err := io.ReadAll(part)
+		if err != nil {
+			return receivedMail{}, err
+		}
+		switch {
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/plain"):
+			received.Text = string(body)
+		case strings.HasPrefix(part.Header.Get("Content-Type"), "text/html"):
+			received.HTML = string(body)
+		}
+	}
+	return received, nil
+}
+
+func newTestEmailSender(t *testing.T, server *smtpServer, repo *Repository, db *pgxpool.Pool) *EmailSender {
+	t.Helper()
+
+	sender, err := NewEmailSender(SMTPConfig{
+		Host: server.host,
+		Port: server.port,
+		From: "Review Bot <review@example.com>",
+	}, repo, db)
+	if err != nil {
+		t.Fatalf("new email sender: %v", err)
+	}
+	return sender
+}
+
+// Письмо собирается и доставляется без обращения к базе, поэтому тест идет без TEST_DATABASE_URL.
+func TestEmailMessageParts(t *testing.T) {
+	server := newSMTPServer(t)
+	sender := newTestEmailSender(t, server, nil, nil)
+
+	n := testNotification()
+	n.PullRequestName = "Search <beta>"
+	n.Link = "https://github.com/pr-1"
+	if err := sender.send(context.Background(), "bob@example.com", string(KindAssigned), n); err != nil {
+		t.Fatalf("send assigned: %v", err)
+	}
+	n.Kind = KindReassigned
+	n.PreviousReviewerName = "carol"
+	if err := sender.send(context.Background(), "bob@example.com", string(KindReassigned), n); err != nil {
+		t.Fatalf("send reassigned: %v", err)
+	}
+
+	messages := server.received()
+	if len(messages) != 2 {
+		t.Fatalf("got %d messages, want 2", len(messages))
+	}
+	assigned, reassigned := messages[0], messages[1]
+
+	if assigned.From != "review@example.com" || assigned.To != "bob@example.com" {
+		t.Errorf("envelope %s -> %s", assigned.From, assigned.To)
+	}
+	if assigned.Subject != "Review requested: Search <beta>" {
+		t.Errorf("assigned subject %q", assigned.Subject)
+	}
+	if !strings.Contains(assigned.Text, `alice asked you to review "Search <beta>" (pr-1) in team backend.`) {
+		t.Errorf("assigned text part: %q", assigned.Text)
+	}
+	if !strings.Contains(assigned.HTML, `<a href="https://github.com/pr-1">Search &lt;beta&gt;</a>`) {
+		t.Errorf("assigned html part: %q", assigned.HTML)
+	}
+
+	if reassigned.Subject != "Review reassigned to you: Search <beta>" {
+		t.Errorf("reassigned subject %q", reassigned.Subject)
+	}
+	if !strings.Contains(reassigned.Text, "by alice instead of carol.") {
+		t.Errorf("reassigned text part: %q", reassigned.Text)
+	}
+	if !strings.Contains(reassigned.HTML, "by alice instead of carol.") {
+		t.Errorf("reassigned html part: %q", reassigned.HTML)
+	}
+}
+
+// emailFixture — база с командой backend: автор alice (u1) и ревьюверы bob (u2) и carol (u3).
+// bob получает все письма, carol отписалась от писем о назначении и дайджеста.
+type emailFixture struct {
+	pool      *pgxpool.Pool
+	repo      *Repository
+	prRepo    *pullrequest.PullRequestRepository
+	userRepo  *user.UserRepository
+	prService *pullrequest.Service
+}
+
+func newEmailFixture(t *testing.T) *emailFixture {
+	t.Helper()
+
+	pool := testdb.New(t)
+	ctx := context.Background()
+	logger := discardLogger()
+
+	f := &emailFixture{
+		pool:     pool,
+		repo:     NewRepository(pool),
+		prRepo:   pullrequest.NewPullRequestRepository(pool),
+		userRepo: user.NewUserRepository(pool),
+	}
+	teamRepo := team.NewTeamRepository(pool)
+	recorder := events.NewRecorder()
+	f.prService = pullrequest.NewService(f.prRepo, f.userRepo, teamRepo, recorder, pool, logger)
+	teamService := team.NewService(teamRepo, f.userRepo, f.prService, recorder, pool, logger)
+
+	if _, err := teamService.CreateTeam(ctx, api.Team{TeamName: "backend", Members: []api.TeamMember{
+		{UserId: "u1", Username: "alice", IsActive: true},
+		{UserId: "u2", Username: "bob", IsActive: true},
+		{UserId: "u3", Username: "carol", IsActive: true},
+	}}); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	for _, settings := range []api.NotificationSettings{
+		{UserId: "u2", Email: "bob@example.com", Assigned: true, Reassigned: true, Digest: true},
+		{UserId: "u3", Email: "carol@example.com", Assigned: false, Reassigned: true, Digest: false},
+	} {
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			return f.repo.SaveSettings(ctx, tx, settings)
+		})
+		if err != nil {
+			t.Fatalf("save settings for %s: %v", settings.UserId, err)
+		}
+	}
+	return f
+}
+
+func TestEmailSenderRespectsSettings(t *testing.T) {
+	f := newEmailFixture(t)
+	server := newSMTPServer(t)
+	sender := newTestEmailSender(t, server, f.repo, f.pool)
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		kind       Kind
+		reviewerID string
+		wantTo     string
+	}{
+		{name: "assigned", kind: KindAssigned, reviewerID: "u2", wantTo: "bob@example.com"},
+		{name: "reassigned", kind: KindReassigned, reviewerID: "u2", wantTo: "bob@example.com"},
+		{name: "assigned opted out", kind: KindAssigned, reviewerID: "u3"},
+		{name: "reassigned opted in", kind: KindReassigned, reviewerID: "u3", wantTo: "carol@example.com"},
+		{name: "no settings", kind: KindAssigned, reviewerID: "u1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(server.received())
+
+			n := testNotification()
+			n.Kind = tt.kind
+			n.ReviewerID = tt.reviewerID
+			err := sender.Send(ctx, n)
+
+			messages := server.received()[before:]
+			if tt.wantTo == "" {
+				if !errors.Is(err, errNoRecipient) {
+					t.Errorf("send: got %v, want errNoRecipient", err)
+				}
+				if len(messages) != 0 {
+					t.Errorf("got %d messages, want none", len(messages))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("send: %v", err)
+			}
+			if len(messages) != 1 || messages[0].To != tt.wantTo {
+				t.Fatalf("got %+v, want one message to %s", messages, tt.wantTo)
+			}
+			if messages[0].Text == "" || messages[0].HTML == "" {
+				t.Errorf("message lacks text or html part: %+v", messages[0])
+			}
+		})
+	}
+}
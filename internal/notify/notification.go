@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"errors"
+	"time"
+)
+
+// Kind — повод уведомления.
+type Kind string
+
+const (
+	KindAssigned   Kind = "assigned"
+	KindReassigned Kind = "reassigned"
+)
+
+// Notification — данные уведомления ревьюверу. Поля доступны в шаблонах сообщений
+// и ссылки, например {{.PullRequestName}} или {{.AuthorName}}.
+type Notification struct {
+	Kind            Kind
+	PullRequestID   string
+	PullRequestName string
+	// PullRequestCreatedAt — время создания PR, по нему считается возраст ревью.
+	PullRequestCreatedAt time.Time
+	TeamName             string
+	AuthorID             string
+	AuthorName           string
+	ReviewerID           string
+	ReviewerName         string
+	// PreviousReviewerID и PreviousReviewerName заполнены для KindReassigned.
+	PreviousReviewerID   string
+	PreviousReviewerName string
+	// Link — ссылка на PR по NOTIFY_LINK_TEMPLATE, пустая, если шаблон не задан.
+	Link string
+}
+
+// errNoRecipient возвращается Sender, если в его канале уведомлять некого: у команды нет канала,
+// у пользователя нет адреса или он отписался. Такое уведомление не повторяется.
+var errNoRecipient = errors.New("no recipient")
+
+// Sender отправляет уведомление в свой канал. Любая ошибка, кроме errNoRecipient,
+// означает, что попытку нужно повторить.
+type Sender interface {
+	Channel() string
+	Send(ctx context.Context, n Notification) error
+}
+
+// kindOf возвращает повод уведомления для события или пустую строку, если уведомлять некого.
+func kindOf(event api.DomainEvent) Kind {
+	if event.ReviewerId == "" {
+		return ""
+	}
+	switch event.Type {
+	case api.DomainEventREVIEWERASSIGNED:
+		return KindAssigned
+	case api.DomainEventREVIEWERREASSIGNED:
+		return KindReassigned
+	default:
+		return ""
+	}
+}
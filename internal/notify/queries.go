@@ -0,0 +1,68 @@
+package notify
+
+var (
+	enqueueNotificationQuery = `
+		INSERT INTO notifications (channel, event_id, payload)
+		VALUES ($1, $2, $3);
+	`
+
+	// Аренда, как у доставок вебхуков: пока уведомление отправляется, next_attempt_at
+	// сдвинут вперед и другой экземпляр сервиса его не заберет
+	claimNotificationsQuery = `
+		UPDATE notifications
+		SET next_attempt_at = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, channel, payload, attempts;
+	`
+
+	deleteNotificationQuery = `
+		DELETE FROM notifications WHERE id = $1;
+	`
+
+	rescheduleNotificationQuery = `
+		UPDATE notifications
+		SET attempts = $2, next_attempt_at = NOW() + make_interval(secs => $3), last_error = $4
+		WHERE id = $1;
+	`
+
+	getSettingsQuery = `
+		SELECT user_id, email, assigned, reassigned, digest
+		FROM notification_settings
+		WHERE user_id = $1;
+	`
+
+	upsertSettingsQuery = `
+		INSERT INTO notification_settings (user_id, email, assigned, reassigned, digest)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET email = EXCLUDED.email,
+			assigned = EXCLUDED.assigned,
+			reassigned = EXCLUDED.reassigned,
+			digest = EXCLUDED.digest,
+			updated_at = NOW();
+	`
+
+	// Следующий пользователь, которому сегодня еще не ушел дайджест. Строка остается
+	// заблокированной до отметки об отправке, поэтому другой экземпляр ее пропустит.
+	claimDigestQuery = `
+		SELECT s.user_id, s.email, u.username
+		FROM notification_settings s
+		JOIN users u ON u.user_id = s.user_id
+		WHERE s.digest AND s.email <> '' AND u.is_active
+		  AND (s.last_digest_on IS NULL OR s.last_digest_on < $1)
+		  AND NOT (s.user_id = ANY($2))
+		ORDER BY s.user_id
+		LIMIT 1
+		FOR UPDATE OF s SKIP LOCKED;
+	`
+
+	markDigestSentQuery = `
+		UPDATE notification_settings SET last_digest_on = $2 WHERE user_id = $1;
+	`
+)
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Recorder ставит назначения ревьюверов в очередь уведомлений в транзакции назначения:
+// по строке на каждый включенный канал. Сама отправка идет в Worker, поэтому недоступный
+// чат не влияет на ответ API.
+type Recorder struct {
+	repo     *Repository
+	channels []string
+}
+
+func NewRecorder(repo *Repository, senders ...Sender) *Recorder {
+	channels := make([]string, 0, len(senders))
+	for _, sender := range senders {
+		channels = append(channels, sender.Channel())
+	}
+	return &Recorder{repo: repo, channels: channels}
+}
+
+func (r *Recorder) Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error {
+	const op = "notify.recorder.Record"
+
+	if kindOf(event) == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, channel := range r.channels {
+		if err := r.repo.Enqueue(ctx, tx, channel, event.Id, payload); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.EventRecorder = (*Recorder)(nil)
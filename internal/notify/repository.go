@@ -0,0 +1,144 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// queued — уведомление, взятое из очереди.
+type queued struct {
+	ID       int64
+	Channel  string
+	Payload  []byte
+	Attempts int
+}
+
+// recipient — получатель дайджеста.
+type recipient struct {
+	UserID   string
+	Email    string
+	Username string
+}
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Enqueue ставит событие в очередь канала channel.
+func (r *Repository) Enqueue(ctx context.Context, tx pgx.Tx, channel, eventID string, payload []byte) error {
+	const op = "notify.repository.Enqueue"
+
+	if _, err := tx.Exec(ctx, enqueueNotificationQuery, channel, eventID, payload); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Claim забирает до limit уведомлений, которым пора уйти, и арендует их на lease.
+func (r *Repository) Claim(ctx context.Context, tx pgx.Tx, limit int, lease time.Duration) ([]queued, error) {
+	const op = "notify.repository.Claim"
+
+	rows, err := tx.Query(ctx, claimNotificationsQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var notifications []queued
+	for rows.Next() {
+		var n queued
+		if err := rows.Scan(&n.ID, &n.Channel, &n.Payload, &n.Attempts); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return notifications, nil
+}
+
+// Delete убирает уведомление из очереди.
+func (r *Repository) Delete(ctx context.Context, tx pgx.Tx, id int64) error {
+	const op = "notify.repository.Delete"
+
+	if _, err := tx.Exec(ctx, deleteNotificationQuery, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Reschedule откладывает уведомление на delay после неудачной попытки.
+func (r *Repository) Reschedule(ctx context.Context, tx pgx.Tx, id int64, attempts int, delay time.Duration, lastError string) error {
+	const op = "notify.repository.Reschedule"
+
+	if _, err := tx.Exec(ctx, rescheduleNotificationQuery, id, attempts, delay.Seconds(), lastError); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetSettings возвращает настройки email-уведомлений пользователя или nil, если он их не менял.
+func (r *Repository) GetSettings(ctx context.Context, tx pgx.Tx, userID string) (*api.NotificationSettings, error) {
+	const op = "notify.repository.GetSettings"
+
+	var settings api.NotificationSettings
+	err := tx.QueryRow(ctx, getSettingsQuery, userID).Scan(
+		&settings.UserId, &settings.Email, &settings.Assigned, &settings.Reassigned, &settings.Digest,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &settings, nil
+}
+
+// SaveSettings сохраняет настройки email-уведомлений пользователя.
+func (r *Repository) SaveSettings(ctx context.Context, tx pgx.Tx, settings api.NotificationSettings) error {
+	const op = "notify.repository.SaveSettings"
+
+	_, err := tx.Exec(ctx, upsertSettingsQuery,
+		settings.UserId, settings.Email, settings.Assigned, settings.Reassigned, settings.Digest,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ClaimDigest блокирует следующего получателя, которому еще не отправлен дайджест за день day.
+// Пользователи из skip пропускаются. Возвращает nil, если таких получателей нет.
+func (r *Repository) ClaimDigest(ctx context.Context, tx pgx.Tx, day time.Time, skip []string) (*recipient, error) {
+	const op = "notify.repository.ClaimDigest"
+
+	var rcpt recipient
+	err := tx.QueryRow(ctx, claimDigestQuery, day, skip).Scan(&rcpt.UserID, &rcpt.Email, &rcpt.Username)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &rcpt, nil
+}
+
+// MarkDigestSent отмечает, что дайджест за день day отправлен.
+func (r *Repository) MarkDigestSent(ctx context.Context, tx pgx.Tx, userID string, day time.Time) error {
+	const op = "notify.repository.MarkDigestSent"
+
+	if _, err := tx.Exec(ctx, markDigestSentQuery, userID, day); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Service управляет настройками email-уведомлений пользователей.
+type Service struct {
+	repo     *Repository
+	userRepo types.UserRepository
+	db       *pgxpool.Pool
+	logger   *slog.Logger
+}
+
+func NewService(repo *Repository, userRepo types.UserRepository, db *pgxpool.Pool, logger *slog.Logger) *Service {
+	return &Service{
+		repo:     repo,
+		userRepo: userRepo,
+		db:       db,
+		logger:   logger,
+	}
+}
+
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// GetNotificationSettings возвращает настройки пользователя. Пока пользователь их не менял,
+// все виды писем включены, но адреса нет, поэтому письма не отправляются.
+func (s *Service) GetNotificationSettings(ctx context.Context, userID string) (settings *api.NotificationSettings, err error) {
+	const op = "notify.service.GetNotificationSettings"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	user, err := s.userRepo.GetByID(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, types.ErrNotFound
+	}
+
+	settings, err = s.repo.GetSettings(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if settings == nil {
+		settings = defaultSettings(userID)
+	}
+	return settings, nil
+}
+
+// UpdateNotificationSettings меняет заданные в update поля настроек, остальные сохраняются.
+// Адрес должен быть одиночным email без имени; пустая строка отключает письма.
+func (s *Service) UpdateNotificationSettings(ctx context.Context, update api.PostUsersNotificationSettingsJSONBody) (settings *api.NotificationSettings, err error) {
+	const op = "notify.service.UpdateNotificationSettings"
+
+	if update.UserId == "" {
+		return nil, fmt.Errorf("%s: user_id is required: %w", op, types.ErrInvalidInput)
+	}
+	if update.Email != nil {
+		email := strings.TrimSpace(*update.Email)
+		if email != "" {
+			addr, err := mail.ParseAddress(email)
+			if err != nil || addr.Name != "" || addr.Address != email {
+				return nil, fmt.Errorf("%s: email must be a plain address: %w", op, types.ErrInvalidInput)
+			}
+		}
+		update.Email = &email
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			settings, err = nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}()
+
+	user, err := s.userRepo.GetByID(ctx, tx, update.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, types.ErrNotFound
+	}
+
+	settings, err = s.repo.GetSettings(ctx, tx, update.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if settings == nil {
+		settings = defaultSettings(update.UserId)
+	}
+
+	if update.Email != nil {
+		settings.Email = *update.Email
+	}
+	if update.Assigned != nil {
+		settings.Assigned = *update.Assigned
+	}
+	if update.Reassigned != nil {
+		settings.Reassigned = *update.Reassigned
+	}
+	if update.Digest != nil {
+		settings.Digest = *update.Digest
+	}
+
+	if err = s.repo.SaveSettings(ctx, tx, *settings); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return settings, nil
+}
+
+func defaultSettings(userID string) *api.NotificationSettings {
+	return &api.NotificationSettings{
+		UserId:     userID,
+		Assigned:   true,
+		Reassigned: true,
+		Digest:     true,
+	}
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.NotificationSettingsService = (*Service)(nil)
@@ -0,0 +1,307 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Результаты обработки уведомления для метрик.
+const (
+	resultSent    = "sent"
+	resultSkipped = "skipped"
+	resultFailed  = "failed"
+	resultDropped = "dropped"
+)
+
+// WorkerConfig задает расписание отправки. Нулевые поля заменяются значениями по умолчанию.
+type WorkerConfig struct {
+	// LinkTemplate — шаблон ссылки на PR, например "https://github.com/{{.PullRequestID}}".
+	LinkTemplate string
+	// MaxAttempts — число неудачных попыток, после которого уведомление отбрасывается.
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+	// Lease — на сколько уведомление скрывается от других экземпляров на время отправки.
+	Lease time.Duration
+}
+
+func (c WorkerConfig) withDefaults() WorkerConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 5
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = 30 * time.Second
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 30 * time.Minute
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 20
+	}
+	if c.Lease <= 0 {
+		c.Lease = 2 * time.Minute
+	}
+	return c
+}
+
+// Worker забирает уведомления из очереди, дополняет их данными PR и пользователей
+// и передает Sender своего канала. Уведомление, которое не ушло за MaxAttempts попыток,
+// отбрасывается с записью в лог: назначение уже сделано, и PR все равно виден в очереди ревьювера.
+type Worker struct {
+	repo     *Repository
+	prRepo   types.PullRequestRepository
+	userRepo types.UserRepository
+	db       *pgxpool.Pool
+	senders  map[string]Sender
+	link     *template.Template
+	cfg      WorkerConfig
+	logger   *slog.Logger
+}
+
+func NewWorker(
+	repo *Repository,
+	prRepo types.PullRequestRepository,
+	userRepo types.UserRepository,
+	db *pgxpool.Pool,
+	senders []Sender,
+	cfg WorkerConfig,
+	logger *slog.Logger,
+) (*Worker, error) {
+	const op = "notify.NewWorker"
+
+	link, err := template.New("link").Parse(cfg.LinkTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("%s: link template: %w", op, err)
+	}
+
+	bySender := make(map[string]Sender, len(senders))
+	for _, sender := range senders {
+		bySender[sender.Channel()] = sender
+	}
+
+	return &Worker{
+		repo:     repo,
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		db:       db,
+		senders:  bySender,
+		link:     link,
+		cfg:      cfg.withDefaults(),
+		logger:   logger,
+	}, nil
+}
+
+// Run отправляет уведомления, пока не отменен ctx.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := w.processBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					w.logger.ErrorContext(ctx, "failed to process notifications", utils.Err(err))
+				}
+				break
+			}
+			if n < w.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processBatch отправляет одну пачку уведомлений параллельно и возвращает ее размер.
+func (w *Worker) processBatch(ctx context.Context) (int, error) {
+	const op = "notify.worker.processBatch"
+
+	var batch []queued
+	err := pgx.BeginFunc(ctx, w.db, func(tx pgx.Tx) error {
+		var err error
+		batch, err = w.repo.Claim(ctx, tx, w.cfg.BatchSize, w.cfg.Lease)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var wg sync.WaitGroup
+	for _, q := range batch {
+		wg.Go(func() { w.attempt(ctx, q) })
+	}
+	wg.Wait()
+
+	return len(batch), nil
+}
+
+// attempt отправляет уведомление и записывает результат. Уведомление удаляется из очереди,
+// если оно ушло, устарело или попытки исчерпаны, и откладывается после обычной неудачи.
+func (w *Worker) attempt(ctx context.Context, q queued) {
+	result, sendErr := w.send(ctx, q)
+	if sendErr != nil && ctx.Err() != nil {
+		// Сервис останавливается: попытка не засчитывается, уведомление вернется после окончания аренды
+		return
+	}
+
+	attempts := q.Attempts + 1
+	if sendErr != nil {
+		result = resultFailed
+		if attempts >= w.cfg.MaxAttempts {
+			result = resultDropped
+		}
+	}
+
+	err := pgx.BeginFunc(ctx, w.db, func(tx pgx.Tx) error {
+		if result == resultFailed {
+			return w.repo.Reschedule(ctx, tx, q.ID, attempts, w.backoff(attempts), sendErr.Error())
+		}
+		return w.repo.Delete(ctx, tx, q.ID)
+	})
+	if err != nil {
+		w.logger.ErrorContext(ctx, "failed to record notification result", "notification_id", q.ID, utils.Err(err))
+		return
+	}
+
+	metrics.Notification(q.Channel, result)
+	switch result {
+	case resultFailed:
+		w.logger.WarnContext(ctx, "notification failed", "notification_id", q.ID, "channel", q.Channel,
+			"attempt", attempts, utils.Err(sendErr))
+	case resultDropped:
+		w.logger.ErrorContext(ctx, "notification dropped", "notification_id", q.ID, "channel", q.Channel,
+			"attempts", attempts, utils.Err(sendErr))
+	}
+}
+
+// send собирает уведомление и передает его Sender канала.
+func (w *Worker) send(ctx context.Context, q queued) (string, error) {
+	sender, ok := w.senders[q.Channel]
+	if !ok {
+		// Канал выключили после постановки в очередь
+		return resultSkipped, nil
+	}
+
+	var event api.DomainEvent
+	if err := json.Unmarshal(q.Payload, &event); err != nil {
+		return "", fmt.Errorf("decode event: %w", err)
+	}
+
+	n, err := w.build(ctx, event)
+	if err != nil {
+		return "", err
+	}
+	if n == nil {
+		return resultSkipped, nil
+	}
+
+	if err := sender.Send(ctx, *n); err != nil {
+		if errors.Is(err, errNoRecipient) {
+			return resultSkipped, nil
+		}
+		return "", err
+	}
+	return resultSent, nil
+}
+
+// build дополняет событие именами PR, автора и ревьюверов. Возвращает nil, если уведомление
+// устарело: PR уже не открыт или ревьювера успели снять.
+func (w *Worker) build(ctx context.Context, event api.DomainEvent) (*Notification, error) {
+	kind := kindOf(event)
+	if kind == "" {
+		return nil, nil
+	}
+
+	var n *Notification
+	err := pgx.BeginFunc(ctx, w.db, func(tx pgx.Tx) error {
+		pr, err := w.prRepo.GetByID(ctx, tx, event.PullRequestId)
+		if err != nil {
+			return err
+		}
+		if pr == nil || pr.Status != api.PullRequestStatusOPEN || !slices.Contains(pr.AssignedReviewers, event.ReviewerId) {
+			return nil
+		}
+
+		n = &Notification{
+			Kind:               kind,
+			PullRequestID:      pr.PullRequestId,
+			PullRequestName:    pr.PullRequestName,
+			TeamName:           event.TeamName,
+			AuthorID:           pr.AuthorId,
+			ReviewerID:         event.ReviewerId,
+			PreviousReviewerID: event.OldReviewerId,
+		}
+		if pr.CreatedAt != nil {
+			n.PullRequestCreatedAt = *pr.CreatedAt
+		}
+
+		if n.AuthorName, err = w.userName(ctx, tx, n.AuthorID); err != nil {
+			return err
+		}
+		if n.ReviewerName, err = w.userName(ctx, tx, n.ReviewerID); err != nil {
+			return err
+		}
+		n.PreviousReviewerName, err = w.userName(ctx, tx, n.PreviousReviewerID)
+		return err
+	})
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	var link strings.Builder
+	if err := w.link.Execute(&link, n); err != nil {
+		return nil, fmt.Errorf("render link: %w", err)
+	}
+	n.Link = link.String()
+	return n, nil
+}
+
+// userName возвращает имя пользователя или его ID, если имени нет.
+func (w *Worker) userName(ctx context.Context, tx pgx.Tx, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+	user, err := w.userRepo.GetByID(ctx, tx, id)
+	if err != nil {
+		return "", err
+	}
+	if user == nil || user.Username == "" {
+		return id, nil
+	}
+	return user.Username, nil
+}
+
+// backoff возвращает задержку перед попыткой после attempts неудачных.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < w.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.cfg.MaxBackoff)
+}
@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/testdb"
+	"deplagene/avito-tech-internship/internal/user"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// failingSender — канал, в который не уходит ни одно уведомление.
+type failingSender struct {
+	calls atomic.Int64
+}
+
+func (s *failingSender) Channel() string {
+	return "failing"
+}
+
+func (s *failingSender) Send(context.Context, Notification) error {
+	s.calls.Add(1)
+	return errors.New("chat is down")
+}
+
+// TestWorkerReschedulesThenDrops проверяет, что неудачная отправка откладывается с записью ошибки,
+// а после MaxAttempts попыток уведомление удаляется из очереди.
+func TestWorkerReschedulesThenDrops(t *testing.T) {
+	pool := testdb.New(t)
+	ctx := context.Background()
+	logger := discardLogger()
+
+	repo := NewRepository(pool)
+	sender := &failingSender{}
+	teamRepo := team.NewTeamRepository(pool)
+	userRepo := user.NewUserRepository(pool)
+	prRepo := pullrequest.NewPullRequestRepository(pool)
+	recorder := events.NewRecorder(NewRecorder(repo, sender))
+	prService := pullrequest.NewService(prRepo, userRepo, teamRepo, recorder, pool, logger)
+	teamService := team.NewService(teamRepo, userRepo, prService, recorder, pool, logger)
+
+	if _, err := teamService.CreateTeam(ctx, api.Team{TeamName: "backend", Members: []api.TeamMember{
+		{UserId: "u1", Username: "alice", IsActive: true},
+		{UserId: "u2", Username: "bob", IsActive: true},
+	}}); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	if _, err := prService.CreatePullRequest(ctx, api.PullRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+	}, ""); err != nil {
+		t.Fatalf("create pull request: %v", err)
+	}
+
+	const backoff = 200 * time.Millisecond
+	worker, err := NewWorker(repo, prRepo, userRepo, pool, []Sender{sender}, WorkerConfig{
+		MaxAttempts: 2,
+		BaseBackoff: backoff,
+		MaxBackoff:  backoff,
+	}, logger)
+	if err != nil {
+		t.Fatalf("new worker: %v", err)
+	}
+
+	if n, err := worker.processBatch(ctx); err != nil || n != 1 {
+		t.Fatalf("first batch: processed %d, err %v; want 1 notification", n, err)
+	}
+	attempts, lastError := queuedNotification(t, pool)
+	if attempts != 1 || lastError != "chat is down" {
+		t.Fatalf("after first failure: attempts %d, last_error %q", attempts, lastError)
+	}
+
+	// До истечения задержки уведомление не забирается повторно
+	if n, err := worker.processBatch(ctx); err != nil || n != 0 {
+		t.Fatalf("batch during backoff: processed %d, err %v; want none", n, err)
+	}
+
+	time.Sleep(2 * backoff)
+	if n, err := worker.processBatch(ctx); err != nil || n != 1 {
+		t.Fatalf("second batch: processed %d, err %v; want 1 notification", n, err)
+	}
+
+	var left int
+	if err := pool.QueryRow(ctx, `SELECT count(*) FROM notifications`).Scan(&left); err != nil {
+		t.Fatalf("count notifications: %v", err)
+	}
+	if left != 0 {
+		t.Errorf("%d notifications left in queue, want dropped", left)
+	}
+	if got := sender.calls.Load(); got != 2 {
+		t.Errorf("sender called %d times, want 2", got)
+	}
+}
+
+func queuedNotification(t *testing.T, pool *pgxpool.Pool) (attempts int, lastError string) {
+	t.Helper()
+
+	err := pool.QueryRow(context.Background(),
+		`SELECT attempts, COALESCE(last_error, '') FROM notifications`).Scan(&attempts, &lastError)
+	if err != nil {
+		t.Fatalf("query notification: %v", err)
+	}
+	return attempts, lastError
+}
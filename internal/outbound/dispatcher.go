@@ -0,0 +1,229 @@
+package outbound
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Заголовки исходящей доставки. Подпись — HMAC-SHA256 тела запроса с секретом подписки
+// в виде "sha256=<hex>", как у вебхуков GitHub.
+const (
+	EventHeader     = "X-Review-Event"
+	DeliveryHeader  = "X-Review-Delivery"
+	SignatureHeader = "X-Review-Signature-256"
+)
+
+// Результаты попытки доставки для метрик.
+const (
+	resultDelivered  = "delivered"
+	resultFailed     = "failed"
+	resultDeadLetter = "dead_letter"
+)
+
+// DispatcherConfig задает расписание доставок. Нулевые поля заменяются значениями по умолчанию.
+type DispatcherConfig struct {
+	// MaxAttempts — число неудачных попыток, после которого доставка уходит в webhook_dead_letters.
+	MaxAttempts int
+	// BaseBackoff — задержка после первой неудачи; каждая следующая вдвое больше, но не больше MaxBackoff.
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+	BatchSize    int
+	// Timeout ограничивает один HTTP-запрос к подписчику.
+	Timeout time.Duration
+}
+
+func (c DispatcherConfig) withDefaults() DispatcherConfig {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 8
+	}
+	if c.BaseBackoff <= 0 {
+		c.BaseBackoff = 10 * time.Second
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = time.Hour
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 20
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	return c
+}
+
+// Dispatcher забирает доставки из outbox и отправляет их подписчикам.
+// Гарантия — at-least-once: подписчик должен отбрасывать повторы по X-Review-Delivery.
+type Dispatcher struct {
+	repo   *Repository
+	db     *pgxpool.Pool
+	client *http.Client
+	cfg    DispatcherConfig
+	logger *slog.Logger
+}
+
+func NewDispatcher(repo *Repository, db *pgxpool.Pool, cfg DispatcherConfig, logger *slog.Logger) *Dispatcher {
+	cfg = cfg.withDefaults()
+	return &Dispatcher{
+		repo:   repo,
+		db:     db,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// Run доставляет события, пока не отменен ctx. Пока в outbox есть due-доставки,
+// пачки забираются без паузы, иначе outbox опрашивается раз в PollInterval.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := d.dispatchBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					d.logger.ErrorContext(ctx, "failed to dispatch webhook deliveries", utils.Err(err))
+				}
+				break
+			}
+			if n < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchBatch доставляет одну пачку параллельно и возвращает ее размер.
+func (d *Dispatcher) dispatchBatch(ctx context.Context) (int, error) {
+	const op = "outbound.dispatcher.dispatchBatch"
+
+	// Аренда с запасом покрывает HTTP-запрос и запись результата
+	lease := 2*d.cfg.Timeout + 30*time.Second
+
+	var deliveries []delivery
+	err := pgx.BeginFunc(ctx, d.db, func(tx pgx.Tx) error {
+		var err error
+		deliveries, err = d.repo.ClaimDeliveries(ctx, tx, d.cfg.BatchSize, lease)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slices.SortFunc(deliveries, func(a, b delivery) int { return cmp.Compare(a.ID, b.ID) })
+
+	var wg sync.WaitGroup
+	for _, dl := range deliveries {
+		wg.Go(func() { d.attempt(ctx, dl) })
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// attempt отправляет доставку и записывает результат: успешная удаляется из outbox,
+// неудачная откладывается по экспоненциальной задержке или уходит в webhook_dead_letters.
+func (d *Dispatcher) attempt(ctx context.Context, dl delivery) {
+	sendErr := d.send(ctx, dl)
+	if sendErr != nil && ctx.Err() != nil {
+		// Сервис останавливается: попытка не засчитывается, доставка вернется после окончания аренды
+		return
+	}
+
+	attempts := dl.Attempts + 1
+	result := resultDelivered
+	err := pgx.BeginFunc(ctx, d.db, func(tx pgx.Tx) error {
+		switch {
+		case sendErr == nil:
+			return d.repo.DeleteDelivery(ctx, tx, dl.ID)
+		case attempts >= d.cfg.MaxAttempts:
+			result = resultDeadLetter
+			return d.repo.DeadLetterDelivery(ctx, tx, dl.ID, attempts, sendErr.Error())
+		default:
+			result = resultFailed
+			return d.repo.RescheduleDelivery(ctx, tx, dl.ID, attempts, d.backoff(attempts), sendErr.Error())
+		}
+	})
+	if err != nil {
+		d.logger.ErrorContext(ctx, "failed to record webhook delivery result", "delivery_id", dl.ID, utils.Err(err))
+		return
+	}
+
+	metrics.WebhookDelivery(result)
+	switch result {
+	case resultFailed:
+		d.logger.WarnContext(ctx, "webhook delivery failed", "delivery_id", dl.ID, "subscription_id", dl.SubscriptionID,
+			"event_type", dl.EventType, "attempt", attempts, utils.Err(sendErr))
+	case resultDeadLetter:
+		d.logger.ErrorContext(ctx, "webhook delivery moved to dead letters", "delivery_id", dl.ID, "subscription_id", dl.SubscriptionID,
+			"event_type", dl.EventType, "attempts", attempts, utils.Err(sendErr))
+	}
+}
+
+// send выполняет один POST к подписчику. Успехом считается любой ответ 2xx.
+func (d *Dispatcher) send(ctx context.Context, dl delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dl.URL, bytes.NewReader(dl.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "review-service-webhooks")
+	req.Header.Set(EventHeader, dl.EventType)
+	req.Header.Set(DeliveryHeader, dl.EventID)
+	req.Header.Set(SignatureHeader, Sign(dl.Secret, dl.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// backoff возвращает задержку перед попыткой после attempts неудачных.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
+
+// Sign возвращает значение заголовка X-Review-Signature-256 для тела body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
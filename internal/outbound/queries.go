@@ -0,0 +1,84 @@
+package outbound
+
+var (
+	createSubscriptionQuery = `
+		INSERT INTO webhook_subscriptions (team_name, url, secret, event_types)
+		VALUES ($1, $2, $3, $4)
+		RETURNING subscription_id::text, created_at;
+	`
+
+	listSubscriptionsQuery = `
+		SELECT subscription_id::text, team_name, url, event_types, created_at
+		FROM webhook_subscriptions
+		WHERE team_name = $1
+		ORDER BY created_at, subscription_id;
+	`
+
+	deleteSubscriptionQuery = `
+		DELETE FROM webhook_subscriptions WHERE subscription_id = $1;
+	`
+
+	// Одна строка outbox на каждую подписку команды, которая слушает этот тип события
+	enqueueDeliveriesQuery = `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		SELECT subscription_id, $2::uuid, $3::text, $4::jsonb
+		FROM webhook_subscriptions
+		WHERE team_name = $1 AND $3::text = ANY(event_types);
+	`
+
+	// Due-доставки забираются с арендой: next_attempt_at сдвигается вперед, поэтому
+	// пока идет HTTP-запрос, их не заберет другой экземпляр сервиса. Если экземпляр упадет,
+	// доставка снова станет due после окончания аренды.
+	claimDeliveriesQuery = `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $2)
+		FROM webhook_subscriptions s
+		WHERE s.subscription_id = d.subscription_id
+		  AND d.id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		  )
+		RETURNING d.id, d.subscription_id::text, d.event_id::text, d.event_type, d.payload, d.attempts, s.url, s.secret;
+	`
+
+	deleteDeliveryQuery = `
+		DELETE FROM webhook_deliveries WHERE id = $1;
+	`
+
+	rescheduleDeliveryQuery = `
+		UPDATE webhook_deliveries
+		SET attempts = $2, next_attempt_at = NOW() + make_interval(secs => $3), last_error = $4
+		WHERE id = $1;
+	`
+
+	deadLetterDeliveryQuery = `
+		WITH failed AS (
+			DELETE FROM webhook_deliveries WHERE id = $1
+			RETURNING subscription_id, event_id, event_type, payload, created_at
+		)
+		INSERT INTO webhook_dead_letters (subscription_id, event_id, event_type, payload, attempts, last_error, created_at)
+		SELECT subscription_id, event_id, event_type, payload, $2, $3, created_at
+		FROM failed;
+	`
+
+	listDeadLettersQuery = `
+		SELECT id, subscription_id::text, event_id::text, event_type, payload, attempts, COALESCE(last_error, ''), failed_at
+		FROM webhook_dead_letters
+		WHERE subscription_id = $1
+		ORDER BY failed_at DESC, id DESC;
+	`
+
+	// Повторная доставка начинается с нуля попыток
+	redeliverDeadLetterQuery = `
+		WITH revived AS (
+			DELETE FROM webhook_dead_letters WHERE id = $1
+			RETURNING subscription_id, event_id, event_type, payload
+		)
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		SELECT subscription_id, event_id, event_type, payload
+		FROM revived;
+	`
+)
@@ -0,0 +1,197 @@
+package outbound
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// delivery — доставка события одному подписчику, взятая из outbox.
+type delivery struct {
+	ID             int64
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Attempts       int
+	URL            string
+	Secret         string
+}
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// CreateSubscription сохраняет подписку и заполняет ее ID и время создания.
+func (r *Repository) CreateSubscription(ctx context.Context, tx pgx.Tx, subscription *api.WebhookSubscription) error {
+	const op = "outbound.repository.CreateSubscription"
+
+	eventTypes := make([]string, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	var createdAt time.Time
+	err := tx.QueryRow(ctx, createSubscriptionQuery,
+		subscription.TeamName, subscription.Url, subscription.Secret, eventTypes,
+	).Scan(&subscription.SubscriptionId, &createdAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	subscription.CreatedAt = &createdAt
+	return nil
+}
+
+// ListSubscriptions возвращает подписки команды без секретов.
+func (r *Repository) ListSubscriptions(ctx context.Context, tx pgx.Tx, teamName string) ([]api.WebhookSubscription, error) {
+	const op = "outbound.repository.ListSubscriptions"
+
+	rows, err := tx.Query(ctx, listSubscriptionsQuery, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	subscriptions := []api.WebhookSubscription{}
+	for rows.Next() {
+		var (
+			subscription api.WebhookSubscription
+			eventTypes   []string
+			createdAt    time.Time
+		)
+		if err := rows.Scan(&subscription.SubscriptionId, &subscription.TeamName, &subscription.Url, &eventTypes, &createdAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, eventType := range eventTypes {
+			subscription.EventTypes = append(subscription.EventTypes, api.DomainEventType(eventType))
+		}
+		subscription.CreatedAt = &createdAt
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscriptions, nil
+}
+
+// DeleteSubscription удаляет подписку вместе с ее доставками. Возвращает false, если подписки не было.
+func (r *Repository) DeleteSubscription(ctx context.Context, tx pgx.Tx, subscriptionID string) (bool, error) {
+	const op = "outbound.repository.DeleteSubscription"
+
+	tag, err := tx.Exec(ctx, deleteSubscriptionQuery, subscriptionID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// EnqueueDeliveries кладет событие в outbox для каждой подписки команды на этот тип события.
+func (r *Repository) EnqueueDeliveries(ctx context.Context, tx pgx.Tx, teamName, eventID string, eventType api.DomainEventType, payload []byte) error {
+	const op = "outbound.repository.EnqueueDeliveries"
+
+	if _, err := tx.Exec(ctx, enqueueDeliveriesQuery, teamName, eventID, string(eventType), payload); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ClaimDeliveries забирает до limit доставок, время попытки которых наступило, и откладывает их на lease.
+func (r *Repository) ClaimDeliveries(ctx context.Context, tx pgx.Tx, limit int, lease time.Duration) ([]delivery, error) {
+	const op = "outbound.repository.ClaimDeliveries"
+
+	rows, err := tx.Query(ctx, claimDeliveriesQuery, limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var deliveries []delivery
+	for rows.Next() {
+		var d delivery
+		if err := rows.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &d.EventType, &d.Payload, &d.Attempts, &d.URL, &d.Secret); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return deliveries, nil
+}
+
+// DeleteDelivery удаляет доставленное событие из outbox.
+func (r *Repository) DeleteDelivery(ctx context.Context, tx pgx.Tx, id int64) error {
+	const op = "outbound.repository.DeleteDelivery"
+
+	if _, err := tx.Exec(ctx, deleteDeliveryQuery, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RescheduleDelivery записывает неудачную попытку и назначает следующую через delay.
+func (r *Repository) RescheduleDelivery(ctx context.Context, tx pgx.Tx, id int64, attempts int, delay time.Duration, lastError string) error {
+	const op = "outbound.repository.RescheduleDelivery"
+
+	if _, err := tx.Exec(ctx, rescheduleDeliveryQuery, id, attempts, delay.Seconds(), lastError); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeadLetterDelivery переносит доставку из outbox в webhook_dead_letters.
+func (r *Repository) DeadLetterDelivery(ctx context.Context, tx pgx.Tx, id int64, attempts int, lastError string) error {
+	const op = "outbound.repository.DeadLetterDelivery"
+
+	if _, err := tx.Exec(ctx, deadLetterDeliveryQuery, id, attempts, lastError); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListDeadLetters возвращает недоставленные события подписки, начиная с последних.
+func (r *Repository) ListDeadLetters(ctx context.Context, tx pgx.Tx, subscriptionID string) ([]api.DeadLetter, error) {
+	const op = "outbound.repository.ListDeadLetters"
+
+	rows, err := tx.Query(ctx, listDeadLettersQuery, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	deadLetters := []api.DeadLetter{}
+	for rows.Next() {
+		var (
+			dl      api.DeadLetter
+			payload []byte
+		)
+		if err := rows.Scan(&dl.Id, &dl.SubscriptionId, &dl.EventId, &dl.EventType, &payload, &dl.Attempts, &dl.LastError, &dl.FailedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		dl.Payload = payload
+		deadLetters = append(deadLetters, dl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return deadLetters, nil
+}
+
+// RedeliverDeadLetter возвращает событие из webhook_dead_letters в outbox. Возвращает false, если записи не было.
+func (r *Repository) RedeliverDeadLetter(ctx context.Context, tx pgx.Tx, id int64) (bool, error) {
+	const op = "outbound.repository.RedeliverDeadLetter"
+
+	tag, err := tx.Exec(ctx, redeliverDeadLetterQuery, id)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,292 @@
+package outbound
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// eventTypes — события, на которые можно подписаться.
+var eventTypes = []api.DomainEventType{
+	api.DomainEventPRCREATED,
+	api.DomainEventREVIEWERASSIGNED,
+	api.DomainEventREVIEWERREASSIGNED,
+	api.DomainEventPRMERGED,
+}
+
+// Service управляет подписками на исходящие вебхуки и кладет события в outbox доставок.
+type Service struct {
+	repo     *Repository
+	teamRepo types.TeamRepository
+	db       *pgxpool.Pool
+	logger   *slog.Logger
+}
+
+func NewService(repo *Repository, teamRepo types.TeamRepository, db *pgxpool.Pool, logger *slog.Logger) *Service {
+	return &Service{
+		repo:     repo,
+		teamRepo: teamRepo,
+		db:       db,
+		logger:   logger,
+	}
+}
+
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// Record кладет событие в outbox в транзакции вызывающего: по строке на каждую подписку
+// команды PR. События PR без команды и события, на которые нельзя подписаться, пропускаются.
+func (s *Service) Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error {
+	const op = "outbound.service.Record"
+
+	if event.TeamName == "" || !slices.Contains(eventTypes, event.Type) {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := s.repo.EnqueueDeliveries(ctx, tx, event.TeamName, event.Id, event.Type, payload); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// CreateSubscription подписывает команду на события ее PR. URL должен быть абсолютным http(s),
+// секрет обязателен: им подписывается каждая доставка.
+func (s *Service) CreateSubscription(ctx context.Context, subscription api.WebhookSubscription) (created *api.WebhookSubscription, err error) {
+	const op = "outbound.service.CreateSubscription"
+
+	if err := validateSubscription(&subscription); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			created, err = nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, subscription.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	if err = s.repo.CreateSubscription(ctx, tx, &subscription); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	subscription.Secret = ""
+	return &subscription, nil
+}
+
+// ListSubscriptions возвращает подписки команды.
+func (s *Service) ListSubscriptions(ctx context.Context, teamName string) (subscriptions []api.WebhookSubscription, err error) {
+	const op = "outbound.service.ListSubscriptions"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	subscriptions, err = s.repo.ListSubscriptions(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscriptions, nil
+}
+
+// DeleteSubscription удаляет подписку. Недоставленные события подписки удаляются вместе с ней.
+func (s *Service) DeleteSubscription(ctx context.Context, subscriptionID string) (err error) {
+	const op = "outbound.service.DeleteSubscription"
+
+	if err := uuid.Validate(subscriptionID); err != nil {
+		return fmt.Errorf("%s: subscription_id must be a uuid: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	deleted, err := s.repo.DeleteSubscription(ctx, tx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !deleted {
+		return types.ErrNotFound
+	}
+	return nil
+}
+
+// ListDeadLetters возвращает события подписки, которые не удалось доставить.
+func (s *Service) ListDeadLetters(ctx context.Context, subscriptionID string) (deadLetters []api.DeadLetter, err error) {
+	const op = "outbound.service.ListDeadLetters"
+
+	if err := uuid.Validate(subscriptionID); err != nil {
+		return nil, fmt.Errorf("%s: subscription_id must be a uuid: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	deadLetters, err = s.repo.ListDeadLetters(ctx, tx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return deadLetters, nil
+}
+
+// RedeliverDeadLetter возвращает недоставленное событие в outbox с новым счетчиком попыток,
+// например после того, как подписчик починил свой endpoint.
+func (s *Service) RedeliverDeadLetter(ctx context.Context, deadLetterID int64) (err error) {
+	const op = "outbound.service.RedeliverDeadLetter"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	revived, err := s.repo.RedeliverDeadLetter(ctx, tx, deadLetterID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !revived {
+		return types.ErrNotFound
+	}
+	return nil
+}
+
+// validateSubscription проверяет подписку и убирает повторы из списка событий.
+func validateSubscription(subscription *api.WebhookSubscription) error {
+	subscription.TeamName = strings.TrimSpace(subscription.TeamName)
+	subscription.Url = strings.TrimSpace(subscription.Url)
+	if subscription.TeamName == "" {
+		return fmt.Errorf("team_name is required: %w", types.ErrInvalidInput)
+	}
+	if subscription.Secret == "" {
+		return fmt.Errorf("secret is required: %w", types.ErrInvalidInput)
+	}
+
+	u, err := url.Parse(subscription.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http(s) url: %w", types.ErrInvalidInput)
+	}
+
+	if len(subscription.EventTypes) == 0 {
+		return fmt.Errorf("event_types must not be empty: %w", types.ErrInvalidInput)
+	}
+	unique := make([]api.DomainEventType, 0, len(subscription.EventTypes))
+	for _, eventType := range subscription.EventTypes {
+		if !slices.Contains(eventTypes, eventType) {
+			return fmt.Errorf("unknown event type %q: %w", eventType, types.ErrInvalidInput)
+		}
+		if !slices.Contains(unique, eventType) {
+			unique = append(unique, eventType)
+		}
+	}
+	subscription.EventTypes = unique
+	return nil
+}
+
+// Проверка соответствия интерфейсам во время компиляции
+var (
+	_ types.EventRecorder       = (*Service)(nil)
+	_ types.SubscriptionService = (*Service)(nil)
+)
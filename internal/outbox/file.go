@@ -0,0 +1,77 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// envelope — строка JSON Lines, в которой файловый и stdout-publisher пишут событие.
+type envelope struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Key       string          `json:"key"`
+	Sequence  int64           `json:"sequence"`
+	CreatedAt time.Time       `json:"created_at"`
+	Event     json.RawMessage `json:"event"`
+}
+
+// writerPublisher пишет события в io.Writer по одному JSON на строку.
+// Годится для локального запуска и отладки, подтверждением считается успешная запись.
+type writerPublisher struct {
+	mu     sync.Mutex
+	w      io.Writer
+	sync   func() error
+	closer io.Closer
+}
+
+func newStdoutPublisher() *writerPublisher {
+	return &writerPublisher{w: os.Stdout}
+}
+
+// newFilePublisher дописывает события в файл path. Файл синхронизируется на диск после каждой пачки.
+func newFilePublisher(path string) (*writerPublisher, error) {
+	if path == "" {
+		return nil, fmt.Errorf("outbox file path is required")
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open outbox file: %w", err)
+	}
+	return &writerPublisher{w: f, sync: f.Sync, closer: f}, nil
+}
+
+func (p *writerPublisher) Publish(ctx context.Context, messages []Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	enc := json.NewEncoder(p.w)
+	for _, msg := range messages {
+		err := enc.Encode(envelope{
+			ID:        msg.ID,
+			Type:      msg.Type,
+			Key:       msg.Key(),
+			Sequence:  msg.Sequence,
+			CreatedAt: msg.CreatedAt,
+			Event:     msg.Payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if p.sync != nil {
+		return p.sync()
+	}
+	return nil
+}
+
+func (p *writerPublisher) Close() error {
+	if p.closer != nil {
+		return p.closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaPublisher публикует события в один топик с ключом агрегата: все события одного PR
+// попадают в одну партицию и читаются в порядке записи. Запись ждет подтверждения всех реплик.
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func newKafkaPublisher(brokers []string, topic string) *kafkaPublisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// Relay сам собирает пачки, ждать добора не нужно
+			BatchTimeout: 10 * time.Millisecond,
+			// Повторяет relay, отправляя пачку заново с первого события
+			MaxAttempts: 1,
+		},
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, messages []Message) error {
+	batch := make([]kafka.Message, 0, len(messages))
+	for _, msg := range messages {
+		batch = append(batch, kafka.Message{
+			Key:   []byte(msg.Key()),
+			Value: msg.Payload,
+			Time:  msg.CreatedAt,
+			Headers: []kafka.Header{
+				{Key: headerKey, Value: []byte(msg.Key())},
+				{Key: headerSequence, Value: []byte(strconv.FormatInt(msg.Sequence, 10))},
+				{Key: headerType, Value: []byte(msg.Type)},
+				{Key: headerID, Value: []byte(msg.ID)},
+			},
+		})
+	}
+
+	if err := p.writer.WriteMessages(ctx, batch...); err != nil {
+		return fmt.Errorf("write to kafka: %w", err)
+	}
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
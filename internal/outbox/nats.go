@@ -0,0 +1,63 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Заголовки сообщений NATS и Kafka.
+const (
+	headerID       = "Review-Event-Id"
+	headerKey      = "Review-Event-Key"
+	headerSequence = "Review-Event-Sequence"
+	headerType     = "Review-Event-Type"
+)
+
+// natsPublisher публикует события в JetStream в subject "<prefix>.<event_type>",
+// например review.reviewer.assigned. Публикация ждет подтверждения от стрима, поэтому
+// стрим на "<prefix>.>" должен существовать. Nats-Msg-Id равен ID события, и JetStream
+// сам отбрасывает повторы в пределах окна дедупликации стрима.
+type natsPublisher struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	prefix string
+}
+
+func newNatsPublisher(url, prefix string) (*natsPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("review-service-outbox"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats: %w", err)
+	}
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("create jetstream context: %w", err)
+	}
+	return &natsPublisher{conn: conn, js: js, prefix: prefix}, nil
+}
+
+// Publish отправляет события по одному: следующее уходит только после подтверждения предыдущего,
+// иначе при ошибке в середине пачки порядок внутри агрегата мог бы нарушиться.
+func (p *natsPublisher) Publish(ctx context.Context, messages []Message) error {
+	for _, msg := range messages {
+		m := nats.NewMsg(p.prefix + "." + msg.Type)
+		m.Data = msg.Payload
+		m.Header.Set(headerID, msg.ID)
+		m.Header.Set(headerKey, msg.Key())
+		m.Header.Set(headerSequence, strconv.FormatInt(msg.Sequence, 10))
+		m.Header.Set(headerType, msg.Type)
+
+		if _, err := p.js.PublishMsg(ctx, m, jetstream.WithMsgID(msg.ID)); err != nil {
+			return fmt.Errorf("publish %s to nats: %w", msg.ID, err)
+		}
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Message — событие из outbox, готовое к публикации. Key определяет порядок:
+// события с одинаковым ключом публикуются в порядке Sequence.
+type Message struct {
+	outboxID int64
+
+	ID            string
+	Type          string
+	AggregateType string
+	AggregateID   string
+	Sequence      int64
+	Payload       []byte
+	CreatedAt     time.Time
+}
+
+// Key — ключ партиционирования: "<aggregate_type>:<aggregate_id>", например "pull_request:pr-1001".
+func (m Message) Key() string {
+	return m.AggregateType + ":" + m.AggregateID
+}
+
+// Publisher отправляет пачку событий во внешнюю систему. Возврат без ошибки означает,
+// что система подтвердила прием всех событий пачки и их можно удалить из outbox.
+// При ошибке пачка публикуется повторно целиком, поэтому получатели должны
+// отбрасывать повторы по ID события.
+type Publisher interface {
+	Publish(ctx context.Context, messages []Message) error
+	Close() error
+}
+
+// PublisherConfig выбирает и настраивает реализацию Publisher.
+type PublisherConfig struct {
+	// Kind — none, stdout, file, nats или kafka.
+	Kind string
+
+	FilePath string
+
+	NatsURL           string
+	NatsSubjectPrefix string
+
+	KafkaBrokers string
+	KafkaTopic   string
+}
+
+// Enabled сообщает, настроена ли публикация. Без нее outbox не заполняется: разбирать его некому.
+func (c PublisherConfig) Enabled() bool {
+	return c.Kind != "" && c.Kind != "none"
+}
+
+// NewPublisher создает Publisher по cfg.Kind. Если публикация не настроена, возвращает nil.
+func NewPublisher(cfg PublisherConfig) (Publisher, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	switch cfg.Kind {
+	case "stdout":
+		return newStdoutPublisher(), nil
+	case "file":
+		return newFilePublisher(cfg.FilePath)
+	case "nats":
+		return newNatsPublisher(cfg.NatsURL, cfg.NatsSubjectPrefix)
+	case "kafka":
+		return newKafkaPublisher(strings.Split(cfg.KafkaBrokers, ","), cfg.KafkaTopic), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox publisher %q (want none, stdout, file, nats or kafka)", cfg.Kind)
+	}
+}
@@ -0,0 +1,38 @@
+package outbox
+
+var (
+	// Номер события берется из outbox_sequences в том же запросе: параллельная транзакция
+	// с событием того же агрегата ждет на строке счетчика, пока эта не зафиксируется
+	insertEventQuery = `
+		WITH seq AS (
+			INSERT INTO outbox_sequences (aggregate_type, aggregate_id, last_sequence)
+			VALUES ($1, $2, 1)
+			ON CONFLICT (aggregate_type, aggregate_id)
+			DO UPDATE SET last_sequence = outbox_sequences.last_sequence + 1
+			RETURNING last_sequence
+		)
+		INSERT INTO outbox (event_id, aggregate_type, aggregate_id, sequence, event_type, payload)
+		SELECT $3::uuid, $1, $2, last_sequence, $4, $5::jsonb
+		FROM seq;
+	`
+
+	listPendingQuery = `
+		SELECT id, event_id::text, aggregate_type, aggregate_id, sequence, event_type, payload, created_at
+		FROM outbox
+		ORDER BY id
+		LIMIT $1;
+	`
+
+	deletePublishedQuery = `
+		DELETE FROM outbox WHERE id = ANY($1);
+	`
+
+	// Блокировка уровня сессии: relay работает только в одном экземпляре сервиса
+	tryRelayLockQuery = `
+		SELECT pg_try_advisory_lock($1);
+	`
+
+	releaseRelayLockQuery = `
+		SELECT pg_advisory_unlock($1);
+	`
+)
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Типы агрегатов, внутри которых упорядочены события.
+const (
+	aggregatePullRequest = "pull_request"
+	aggregateTeam        = "team"
+	aggregateUser        = "user"
+)
+
+// Recorder записывает доменные события в outbox в транзакции вызывающего сервиса.
+type Recorder struct {
+	repo *Repository
+}
+
+func NewRecorder(repo *Repository) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+func (r *Recorder) Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error {
+	const op = "outbox.recorder.Record"
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	aggregateType, aggregateID := aggregateOf(event)
+	msg := Message{
+		ID:            event.Id,
+		Type:          string(event.Type),
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+	}
+	if err := r.repo.Insert(ctx, tx, msg); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// aggregateOf определяет, в каком агрегате упорядочено событие: события PR и его ревьюверов —
+// в PR, user.* — в пользователе, остальные события команд — в команде.
+func aggregateOf(event api.DomainEvent) (string, string) {
+	switch {
+	case event.PullRequestId != "":
+		return aggregatePullRequest, event.PullRequestId
+	case strings.HasPrefix(string(event.Type), "user."):
+		return aggregateUser, event.UserId
+	default:
+		return aggregateTeam, event.TeamName
+	}
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.EventRecorder = (*Recorder)(nil)
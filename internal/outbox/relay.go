@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/utils"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// relayLockKey — ключ advisory lock, которым экземпляры сервиса выбирают единственный relay.
+const relayLockKey int64 = 0x6f7574626f78 // "outbox"
+
+// RelayConfig задает расписание relay. Нулевые поля заменяются значениями по умолчанию.
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// MaxBackoff ограничивает паузу между попытками, пока брокер недоступен.
+	MaxBackoff time.Duration
+}
+
+func (c RelayConfig) withDefaults() RelayConfig {
+	if c.PollInterval <= 0 {
+		c.PollInterval = time.Second
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = 100
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = 30 * time.Second
+	}
+	return c
+}
+
+// Relay переносит события из outbox в Publisher. Доставка at-least-once: события удаляются
+// из outbox только после подтверждения брокера, и при сбое между публикацией и удалением
+// пачка уйдет повторно. Порядок внутри агрегата сохраняется: публикует только один экземпляр
+// (держатель advisory lock), строго по возрастанию id и без обгона неудачной пачки.
+type Relay struct {
+	repo      *Repository
+	db        *pgxpool.Pool
+	publisher Publisher
+	cfg       RelayConfig
+	logger    *slog.Logger
+}
+
+func NewRelay(repo *Repository, db *pgxpool.Pool, publisher Publisher, cfg RelayConfig, logger *slog.Logger) *Relay {
+	return &Relay{
+		repo:      repo,
+		db:        db,
+		publisher: publisher,
+		cfg:       cfg.withDefaults(),
+		logger:    logger,
+	}
+}
+
+// Run публикует события, пока не отменен ctx. Экземпляр, не получивший блокировку,
+// периодически пробует снова и подхватит публикацию, если текущий relay остановится.
+func (r *Relay) Run(ctx context.Context) {
+	backoff := r.cfg.PollInterval
+	for {
+		err := r.lead(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			r.logger.ErrorContext(ctx, "outbox relay failed", utils.Err(err), "retry_in", backoff)
+			backoff = min(2*backoff, r.cfg.MaxBackoff)
+		default:
+			backoff = r.cfg.PollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// lead захватывает advisory lock на выделенном соединении и публикует события, пока держит его.
+// Возвращает nil сразу, если relay уже работает в другом экземпляре.
+func (r *Relay) lead(ctx context.Context) error {
+	const op = "outbox.relay.lead"
+
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err := conn.QueryRow(ctx, tryRelayLockQuery, relayLockKey).Scan(&locked); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !locked {
+		return nil
+	}
+	defer func() {
+		// Блокировка снимается и при отмене ctx, иначе соединение вернется в пул с ней
+		unlockCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		if _, err := conn.Exec(unlockCtx, releaseRelayLockQuery, relayLockKey); err != nil {
+			r.logger.ErrorContext(ctx, "failed to release outbox relay lock", utils.Err(err))
+			conn.Conn().Close(unlockCtx)
+		}
+	}()
+	r.logger.InfoContext(ctx, "outbox relay started")
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		n, err := r.relayBatch(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if n == r.cfg.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch публикует самую старую пачку событий и удаляет ее из outbox.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	var n int
+	err := pgx.BeginFunc(ctx, r.db, func(tx pgx.Tx) error {
+		messages, err := r.repo.ListPending(ctx, tx, r.cfg.BatchSize)
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		if err := r.publisher.Publish(ctx, messages); err != nil {
+			return err
+		}
+		if err := r.repo.DeletePublished(ctx, tx, messages); err != nil {
+			return err
+		}
+
+		n = len(messages)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.OutboxPublished(n)
+	return n, nil
+}
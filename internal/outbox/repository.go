@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Insert записывает событие в outbox и присваивает ему следующий номер в агрегате.
+func (r *Repository) Insert(ctx context.Context, tx pgx.Tx, msg Message) error {
+	const op = "outbox.repository.Insert"
+
+	_, err := tx.Exec(ctx, insertEventQuery, msg.AggregateType, msg.AggregateID, msg.ID, msg.Type, msg.Payload)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListPending возвращает до limit неопубликованных событий в порядке записи.
+func (r *Repository) ListPending(ctx context.Context, tx pgx.Tx, limit int) ([]Message, error) {
+	const op = "outbox.repository.ListPending"
+
+	rows, err := tx.Query(ctx, listPendingQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		var msg Message
+		if err := rows.Scan(&msg.outboxID, &msg.ID, &msg.AggregateType, &msg.AggregateID, &msg.Sequence, &msg.Type, &msg.Payload, &msg.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return messages, nil
+}
+
+// DeletePublished удаляет опубликованные события.
+func (r *Repository) DeletePublished(ctx context.Context, tx pgx.Tx, messages []Message) error {
+	const op = "outbox.repository.DeletePublished"
+
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.outboxID)
+	}
+	if _, err := tx.Exec(ctx, deletePublishedQuery, ids); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
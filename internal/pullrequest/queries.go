@@ -2,8 +2,8 @@ package pullrequest
 
 var (
 	createPullRequestQuery = `
-		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at)
-		VALUES ($1, $2, $3, $4, $5);
+		INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, team_name, initial_reviewer_count)
+		VALUES ($1, $2, $3, $4, $5, $6, $7);
 	`
 
 	getPullRequestByIdQuery = `
@@ -15,10 +15,30 @@ var (
 		GROUP BY pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at;
 	`
 
+	getPullRequestTeamQuery = `
+		SELECT COALESCE(team_name, '') FROM pull_requests WHERE pull_request_id = $1;
+	`
+
+	lockPullRequestQuery = `
+		SELECT pull_request_id FROM pull_requests WHERE pull_request_id = $1 FOR UPDATE;
+	`
+
 	setMergeStatusQuery = `
 		UPDATE pull_requests SET status = $1, merged_at = $2 WHERE pull_request_id = $3 AND status = $4;
 	`
 
+	setCloseStatusQuery = `
+		UPDATE pull_requests SET status = $1, closed_at = $2 WHERE pull_request_id = $3 AND status = $4;
+	`
+
+	setReopenStatusQuery = `
+		UPDATE pull_requests SET status = $1, closed_at = NULL WHERE pull_request_id = $2 AND status = $3;
+	`
+
+	setDraftQuery = `
+		UPDATE pull_requests SET is_draft = $1 WHERE pull_request_id = $2 AND is_draft <> $1;
+	`
+
 	addReviewerQuery = `
 		INSERT INTO reviewers (pull_request_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING;	
 	`
@@ -27,6 +47,19 @@ var (
 		DELETE FROM reviewers WHERE pull_request_id = $1 AND user_id = $2;
 	`
 
+	addReviewEventQuery = `
+		INSERT INTO review_events (pull_request_id, user_id, event_type, team_name, strategy)
+		VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, ''), NULLIF($5, ''));
+	`
+
+	getOpenPullRequestIDsByReviewerQuery = `
+		SELECT pr.pull_request_id
+		FROM pull_requests pr
+		JOIN reviewers rev ON pr.pull_request_id = rev.pull_request_id
+		WHERE rev.user_id = $1 AND pr.status = 'OPEN' AND ($2 = '' OR pr.team_name = $2)
+		ORDER BY pr.pull_request_id;
+	`
+
 	getPullRequestsByReviewerQuery = `
 		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
 		FROM pull_requests pr
@@ -4,6 +4,7 @@ import (
 	"context"
 	"deplagene/avito-tech-internship/cmd/api"
 	"deplagene/avito-tech-internship/types"
+	"errors"
 	"fmt"
 	"time"
 
@@ -19,11 +20,17 @@ func NewPullRequestRepository(db *pgxpool.Pool) *PullRequestRepository {
 	return &PullRequestRepository{db: db}
 }
 
-// Create создает новый Pull Request и назначает ревьюверов.
-func (r *PullRequestRepository) Create(ctx context.Context, tx pgx.Tx, pr api.PullRequest) error {
+// Create создает новый Pull Request, относит его к команде teamName и назначает ревьюверов.
+// Пустой teamName означает, что PR не относится ни к одной команде.
+func (r *PullRequestRepository) Create(ctx context.Context, tx pgx.Tx, pr api.PullRequest, teamName string) error {
 	const op = "pullrequest.repository.Create"
 
-	_, err := tx.Exec(ctx, createPullRequestQuery, pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, time.Now())
+	var team *string
+	if teamName != "" {
+		team = &teamName
+	}
+
+	_, err := tx.Exec(ctx, createPullRequestQuery, pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, time.Now(), team, len(pr.AssignedReviewers))
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -63,6 +70,31 @@ func (r *PullRequestRepository) GetByID(ctx context.Context, tx pgx.Tx, id strin
 	return pr, nil
 }
 
+// GetTeamByID возвращает команду, к которой отнесен Pull Request, или пустую строку.
+func (r *PullRequestRepository) GetTeamByID(ctx context.Context, tx pgx.Tx, id string) (string, error) {
+	const op = "pullrequest.repository.GetTeamByID"
+
+	var teamName string
+	if err := tx.QueryRow(ctx, getPullRequestTeamQuery, id).Scan(&teamName); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return teamName, nil
+}
+
+// LockByID блокирует строку Pull Request'а до конца транзакции (SELECT ... FOR UPDATE).
+// Если Pull Request'а не существует, блокировка ничего не делает.
+func (r *PullRequestRepository) LockByID(ctx context.Context, tx pgx.Tx, id string) error {
+	const op = "pullrequest.repository.LockByID"
+
+	if _, err := tx.Exec(ctx, lockPullRequestQuery, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
 // Merge помечает Pull Request как MERGED.
 func (r *PullRequestRepository) Merge(ctx context.Context, tx pgx.Tx, id string) error {
 	const op = "pullrequest.repository.Merge"
@@ -74,6 +106,39 @@ func (r *PullRequestRepository) Merge(ctx context.Context, tx pgx.Tx, id string)
 	return nil
 }
 
+// Close помечает открытый Pull Request как CLOSED (закрыт без merge).
+func (r *PullRequestRepository) Close(ctx context.Context, tx pgx.Tx, id string) error {
+	const op = "pullrequest.repository.Close"
+
+	_, err := tx.Exec(ctx, setCloseStatusQuery, api.PullRequestStatusCLOSED, time.Now(), id, api.PullRequestStatusOPEN)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Reopen возвращает закрытый Pull Request в статус OPEN.
+func (r *PullRequestRepository) Reopen(ctx context.Context, tx pgx.Tx, id string) error {
+	const op = "pullrequest.repository.Reopen"
+
+	_, err := tx.Exec(ctx, setReopenStatusQuery, api.PullRequestStatusOPEN, id, api.PullRequestStatusCLOSED)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SetDraft меняет признак черновика. Возвращает false, если PR уже был в этом состоянии.
+func (r *PullRequestRepository) SetDraft(ctx context.Context, tx pgx.Tx, id string, draft bool) (bool, error) {
+	const op = "pullrequest.repository.SetDraft"
+
+	tag, err := tx.Exec(ctx, setDraftQuery, draft, id)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
 // AddReviewer добавляет ревьювера к Pull Request'у.
 func (r *PullRequestRepository) AddReviewer(ctx context.Context, tx pgx.Tx, prID, userID string) error {
 	const op = "pullrequest.repository.AddReviewer"
@@ -96,6 +161,17 @@ func (r *PullRequestRepository) RemoveReviewer(ctx context.Context, tx pgx.Tx, p
 	return nil
 }
 
+// AddEvent записывает событие в историю назначений ревьюверов.
+func (r *PullRequestRepository) AddEvent(ctx context.Context, tx pgx.Tx, event api.ReviewEvent) error {
+	const op = "pullrequest.repository.AddEvent"
+
+	_, err := tx.Exec(ctx, addReviewEventQuery, event.PullRequestId, event.UserId, event.Type, event.TeamName, event.Strategy)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
 // GetByReviewer возвращает список Pull Request'ов, где пользователь назначен ревьювером.
 func (r *PullRequestRepository) GetByReviewer(ctx context.Context, tx pgx.Tx, userID string) ([]api.PullRequestShort, error) {
 	const op = "pullrequest.repository.GetByReviewer"
@@ -123,5 +199,30 @@ func (r *PullRequestRepository) GetByReviewer(ctx context.Context, tx pgx.Tx, us
 	return prs, nil
 }
 
+// GetOpenIDsByReviewer возвращает ID открытых Pull Request'ов команды teamName, где пользователь
+// назначен ревьювером. Пустой teamName означает PR всех команд.
+func (r *PullRequestRepository) GetOpenIDsByReviewer(ctx context.Context, tx pgx.Tx, userID, teamName string) ([]string, error) {
+	const op = "pullrequest.repository.GetOpenIDsByReviewer"
+
+	rows, err := tx.Query(ctx, getOpenPullRequestIDsByReviewerQuery, userID, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ids, nil
+}
+
 // Проверка соответствия интерфейсу во время компиляции
 var _ types.PullRequestRepository = (*PullRequestRepository)(nil)
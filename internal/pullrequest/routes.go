@@ -2,38 +2,62 @@ package pullrequest
 
 import (
 	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
 	"deplagene/avito-tech-internship/types"
 	"deplagene/avito-tech-internship/utils"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 )
 
+// maxImportSize ограничивает размер CSV-файла в /team/import.
+const maxImportSize = 10 << 20
+
 type Handler struct {
-	teamService types.TeamService
-	userService types.UserService
-	prService   types.PullRequestService
-	logger      *slog.Logger
+	teamService     types.TeamService
+	userService     types.UserService
+	prService       types.PullRequestService
+	statsService    types.StatsService
+	identityService types.IdentityService
+	subscriptions   types.SubscriptionService
+	notifications   types.NotificationSettingsService
+	logger          *slog.Logger
 }
 
 func NewHandler(
 	teamService types.TeamService,
 	userService types.UserService,
 	prService types.PullRequestService,
+	statsService types.StatsService,
+	identityService types.IdentityService,
+	subscriptions types.SubscriptionService,
+	notifications types.NotificationSettingsService,
 	logger *slog.Logger,
 ) *Handler {
 	return &Handler{
-		teamService: teamService,
-		userService: userService,
-		prService:   prService,
-		logger:      logger,
+		teamService:     teamService,
+		userService:     userService,
+		prService:       prService,
+		statsService:    statsService,
+		identityService: identityService,
+		subscriptions:   subscriptions,
+		notifications:   notifications,
+		logger:          logger,
 	}
 }
 
+// log возвращает логгер с request_id и пользователем текущего запроса.
+func (h *Handler) log(r *http.Request) *slog.Logger {
+	return logging.FromContext(r.Context(), h.logger)
+}
+
 // handleError отправляет стандартизированный ответ об ошибке.
 func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error) {
-	h.logger.Info("handleError received error", "error", err.Error(), "type", fmt.Sprintf("%T", err))
+	h.log(r).DebugContext(r.Context(), "handleError received error", "error", err.Error(), "type", fmt.Sprintf("%T", err))
 	var code api.ErrorResponseErrorCode
 	var message string
 	var httpStatus int
@@ -51,6 +75,10 @@ func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error)
 		code = api.PRMERGED
 		message = "cannot reassign on merged PR"
 		httpStatus = http.StatusConflict
+	case errors.Is(err, types.ErrPRClosed):
+		code = api.PRCLOSED
+		message = "pr is closed"
+		httpStatus = http.StatusConflict
 	case errors.Is(err, types.ErrNotAssigned):
 		code = api.NOTASSIGNED
 		message = "reviewer is not assigned to this PR"
@@ -59,11 +87,29 @@ func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error)
 		code = api.NOCANDIDATE
 		message = "no active replacement candidate in team"
 		httpStatus = http.StatusConflict
+	case errors.Is(err, types.ErrUserInOtherTeam):
+		code = api.USERINOTHERTEAM
+		message = "user already belongs to another team, use /users/move or /team/addMember"
+		httpStatus = http.StatusConflict
+	case errors.Is(err, types.ErrInvalidInput):
+		code = api.INVALIDINPUT
+		message = "invalid input"
+		httpStatus = http.StatusBadRequest
+	case errors.Is(err, types.ErrTeamRequired):
+		code = api.TEAMREQUIRED
+		message = "author belongs to several teams, team_name is required"
+		httpStatus = http.StatusBadRequest
+	case errors.Is(err, types.ErrTeamHasOpenReviews):
+		code = api.TEAMHASOPENREVIEWS
+		message = "team pull requests still have open reviews"
+		httpStatus = http.StatusConflict
 	default:
-		h.logger.Error("Internal Server Error", "error", err, "path", r.URL.Path)
-		utils.WriteError(w, h.logger, http.StatusInternalServerError, err)
+		metrics.APIError("INTERNAL")
+		h.log(r).ErrorContext(r.Context(), "Internal Server Error", "error", err, "path", r.URL.Path)
+		utils.WriteError(w, h.log(r), http.StatusInternalServerError, err)
 		return
 	}
+	metrics.APIError(string(code))
 
 	resp := api.ErrorResponse{
 		Error: struct {
@@ -76,16 +122,17 @@ func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error)
 	}
 
 	if err := utils.WriteJson(w, httpStatus, resp); err != nil {
-		h.logger.Error("Failed to write error response", "error", err, "path", r.URL.Path)
-		utils.WriteError(w, h.logger, http.StatusInternalServerError, err)
+		h.log(r).ErrorContext(r.Context(), "Failed to write error response", "error", err, "path", r.URL.Path)
+		utils.WriteError(w, h.log(r), http.StatusInternalServerError, err)
 	}
 }
 
-// PostPullRequestCreate создает PR и автоматически назначает до 2 ревьюверов из команды автора
+// PostPullRequestCreate создает PR и автоматически назначает ревьюверов из команды автора.
+// Если автор состоит в нескольких командах, команду PR нужно указать в team_name
 func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	var body api.PostPullRequestCreateJSONRequestBody
 	if err := utils.ParseJson(r, &body); err != nil {
-		utils.WriteError(w, h.logger, http.StatusBadRequest, err)
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
 		return
 	}
 
@@ -95,7 +142,7 @@ func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 		AuthorId:        body.AuthorId,
 	}
 
-	createdPR, err := h.prService.CreatePullRequest(r.Context(), pr)
+	createdPR, err := h.prService.CreatePullRequest(r.Context(), pr, body.TeamName)
 	if err != nil {
 		h.handleError(w, r, err)
 		return
@@ -116,7 +163,7 @@ func (h *Handler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
 func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	var body api.PostPullRequestMergeJSONRequestBody
 	if err := utils.ParseJson(r, &body); err != nil {
-		utils.WriteError(w, h.logger, http.StatusBadRequest, err)
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
 		return
 	}
 
@@ -141,7 +188,7 @@ func (h *Handler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 	var body api.PostPullRequestReassignJSONRequestBody
 	if err := utils.ParseJson(r, &body); err != nil {
-		utils.WriteError(w, h.logger, http.StatusBadRequest, err)
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
 		return
 	}
 
@@ -164,11 +211,12 @@ func (h *Handler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// PostTeamAdd создает команду с участниками (создаёт/обновляет пользователей)
+// PostTeamAdd создает команду с участниками (создаёт/обновляет пользователей).
+// Участники другой команды отклоняются: переезд выполняется через /users/move
 func (h *Handler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	var body api.PostTeamAddJSONRequestBody
 	if err := utils.ParseJson(r, &body); err != nil {
-		utils.WriteError(w, h.logger, http.StatusBadRequest, err)
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
 		return
 	}
 
@@ -189,8 +237,14 @@ func (h *Handler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet получает команду с участниками
+// GetTeamGet получает команду с участниками.
+// С устаревшим include_subtree=true отвечает так же, как /team/tree
 func (h *Handler) GetTeamGet(w http.ResponseWriter, r *http.Request, params api.GetTeamGetParams) {
+	if params.IncludeSubtree != nil && *params.IncludeSubtree {
+		h.GetTeamTree(w, r, api.GetTeamTreeParams{TeamName: params.TeamName})
+		return
+	}
+
 	team, err := h.teamService.GetTeam(r.Context(), params.TeamName)
 	if err != nil {
 		h.handleError(w, r, err)
@@ -202,6 +256,235 @@ func (h *Handler) GetTeamGet(w http.ResponseWriter, r *http.Request, params api.
 	}
 }
 
+// GetTeamTree возвращает команду с настройками и всем поддеревом дочерних команд
+func (h *Handler) GetTeamTree(w http.ResponseWriter, r *http.Request, params api.GetTeamTreeParams) {
+	tree, err := h.teamService.GetTeamTree(r.Context(), params.TeamName)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, tree); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamImport импортирует состав команд из CSV (колонки user_id, username, team_name, is_active).
+// Файл передается телом запроса или полем file в multipart/form-data.
+// С dry_run=true только проверяет файл и возвращает ошибки по строкам
+func (h *Handler) PostTeamImport(w http.ResponseWriter, r *http.Request, params api.PostTeamImportParams) {
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	var file io.Reader = r.Body
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "multipart/form-data" {
+		formFile, _, err := r.FormFile("file")
+		if err != nil {
+			utils.WriteError(w, h.log(r), http.StatusBadRequest, fmt.Errorf("file is required: %w", err))
+			return
+		}
+		defer formFile.Close()
+		file = formFile
+	}
+
+	result, err := h.teamService.ImportTeamsCSV(r.Context(), file, dryRun)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	status := http.StatusOK
+	if !result.DryRun && !result.Applied {
+		status = http.StatusUnprocessableEntity
+	}
+
+	if err := utils.WriteJson(w, status, result); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetTeamExport выгружает состав всех команд. Поддерживается только format=csv
+func (h *Handler) GetTeamExport(w http.ResponseWriter, r *http.Request, params api.GetTeamExportParams) {
+	if params.Format != nil && *params.Format != api.GetTeamExportParamsFormatCsv {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, fmt.Errorf("unsupported format %q", *params.Format))
+		return
+	}
+
+	data, err := h.teamService.ExportTeamsCSV(r.Context())
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="teams.csv"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		h.log(r).ErrorContext(r.Context(), "Failed to write csv response", "error", err, "path", r.URL.Path)
+	}
+}
+
+// PostTeamAddMember добавляет существующего пользователя в еще одну команду
+func (h *Handler) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamAddMemberJSONRequestBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	team, err := h.teamService.AddMember(r.Context(), body.TeamName, body.UserId)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Team *api.Team `json:"team"`
+	}{
+		Team: team,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamRemoveMember исключает пользователя из команды и переназначает его открытые ревью
+func (h *Handler) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamRemoveMemberJSONRequestBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.teamService.RemoveMember(r.Context(), body.TeamName, body.UserId); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	team, err := h.teamService.GetTeam(r.Context(), body.TeamName)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Team *api.Team `json:"team"`
+	}{
+		Team: team,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamRename переименовывает команду
+func (h *Handler) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamRenameJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	renamedTeam, err := h.teamService.RenameTeam(r.Context(), body.TeamName, body.NewTeamName)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Team *api.Team `json:"team"`
+	}{
+		Team: renamedTeam,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamDelete удаляет команду, если у ее участников нет открытых ревью
+func (h *Handler) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamDeleteJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.teamService.DeleteTeam(r.Context(), body.TeamName); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		TeamName string `json:"team_name"`
+		Deleted  bool   `json:"deleted"`
+	}{
+		TeamName: body.TeamName,
+		Deleted:  true,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamSetParent перемещает команду в иерархии (org → department → team)
+func (h *Handler) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamSetParentJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	node, err := h.teamService.SetParentTeam(r.Context(), body.TeamName, body.ParentTeam)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Team *api.TeamNode `json:"team"`
+	}{
+		Team: node,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostTeamSettings задает собственные настройки команды; незаданные поля наследуются от родителя
+func (h *Handler) PostTeamSettings(w http.ResponseWriter, r *http.Request) {
+	var body api.PostTeamSettingsJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	settings := api.TeamSettings{
+		ReviewerCount: body.ReviewerCount,
+		Strategy:      body.Strategy,
+		SlaHours:      body.SlaHours,
+	}
+	node, err := h.teamService.UpdateTeamSettings(r.Context(), body.TeamName, settings)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Team *api.TeamNode `json:"team"`
+	}{
+		Team: node,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
 // GetUsersGetReview получает PR'ы, где пользователь назначен ревьювером
 func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params api.GetUsersGetReviewParams) {
 	prs, err := h.prService.GetPullRequestsByReviewer(r.Context(), params.UserId)
@@ -227,7 +510,7 @@ func (h *Handler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, para
 func (h *Handler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	var body api.PostUsersSetIsActiveJSONRequestBody
 	if err := utils.ParseJson(r, &body); err != nil {
-		utils.WriteError(w, h.logger, http.StatusBadRequest, err)
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
 		return
 	}
 
@@ -248,12 +531,355 @@ func (h *Handler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// PostUsersMove явно переводит пользователя в другую команду
+func (h *Handler) PostUsersMove(w http.ResponseWriter, r *http.Request) {
+	var body api.PostUsersMoveJSONRequestBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	movedUser, err := h.userService.MoveUser(r.Context(), body.UserId, body.TeamName)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		User *api.User `json:"user"`
+	}{
+		User: movedUser,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetUsersGet возвращает карточку пользователя
+func (h *Handler) GetUsersGet(w http.ResponseWriter, r *http.Request, params api.GetUsersGetParams) {
+	userID := params.UserId
+
+	profile, err := h.userService.GetUser(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		User *api.UserProfile `json:"user"`
+	}{
+		User: profile,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostUsersLinkIdentity связывает логин в GitHub/GitLab с пользователем,
+// чтобы PR из вебхуков создавались от его имени
+func (h *Handler) PostUsersLinkIdentity(w http.ResponseWriter, r *http.Request) {
+	var body api.GitIdentity
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	identity, err := h.identityService.LinkIdentity(r.Context(), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Identity *api.GitIdentity `json:"identity"`
+	}{
+		Identity: identity,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostUsersUnlinkIdentity удаляет связь логина с пользователем
+func (h *Handler) PostUsersUnlinkIdentity(w http.ResponseWriter, r *http.Request) {
+	var body api.PostUsersUnlinkIdentityJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.identityService.UnlinkIdentity(r.Context(), body.Provider, body.Login); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetUsersIdentities возвращает логины пользователя в GitHub/GitLab
+func (h *Handler) GetUsersIdentities(w http.ResponseWriter, r *http.Request, params api.GetUsersIdentitiesParams) {
+	userID := params.UserId
+
+	identities, err := h.identityService.ListIdentities(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		UserID     string            `json:"user_id"`
+		Identities []api.GitIdentity `json:"identities"`
+	}{
+		UserID:     userID,
+		Identities: identities,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetUsersNotificationSettings возвращает настройки email-уведомлений пользователя
+func (h *Handler) GetUsersNotificationSettings(w http.ResponseWriter, r *http.Request, params api.GetUsersNotificationSettingsParams) {
+	userID := params.UserId
+
+	settings, err := h.notifications.GetNotificationSettings(r.Context(), userID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Settings *api.NotificationSettings `json:"settings"`
+	}{
+		Settings: settings,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostUsersNotificationSettings задает адрес и включает или отключает отдельные виды писем
+func (h *Handler) PostUsersNotificationSettings(w http.ResponseWriter, r *http.Request) {
+	var body api.PostUsersNotificationSettingsJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	settings, err := h.notifications.UpdateNotificationSettings(r.Context(), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Settings *api.NotificationSettings `json:"settings"`
+	}{
+		Settings: settings,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetUsersList возвращает справочник пользователей с фильтрами по команде, активности и роли,
+// поиском по username (search=prefix|trigram) и курсорной пагинацией
+func (h *Handler) GetUsersList(w http.ResponseWriter, r *http.Request, params api.GetUsersListParams) {
+	page, err := h.userService.ListUsers(r.Context(), params)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, page); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetStatsReviewers возвращает статистику ревьюверов с фильтром по команде
+// и окном времени from/to (RFC 3339 или YYYY-MM-DD)
+func (h *Handler) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params api.GetStatsReviewersParams) {
+	report, err := h.statsService.GetReviewerStats(r.Context(), params)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, report); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetStatsTeams возвращает пропускную способность и время цикла команд за окно from/to.
+// С format=csv отчет отдается в CSV
+func (h *Handler) GetStatsTeams(w http.ResponseWriter, r *http.Request, params api.GetStatsTeamsParams) {
+	format := api.GetStatsTeamsParamsFormatJson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != api.GetStatsTeamsParamsFormatJson && format != api.GetStatsTeamsParamsFormatCsv {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, fmt.Errorf("unsupported format %q", format))
+		return
+	}
+
+	if format == api.GetStatsTeamsParamsFormatCsv {
+		data, err := h.statsService.GetTeamStatsCSV(r.Context(), params)
+		if err != nil {
+			h.handleError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="team-stats.csv"`)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(data); err != nil {
+			h.log(r).ErrorContext(r.Context(), "Failed to write csv response", "error", err, "path", r.URL.Path)
+		}
+		return
+	}
+
+	report, err := h.statsService.GetTeamStats(r.Context(), params)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, report); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetStatsFairness возвращает показатели равномерности нагрузки ревьюверов по командам
+// (коэффициент Джини, отношение max/min, стандартное отклонение) и список выбросов
+func (h *Handler) GetStatsFairness(w http.ResponseWriter, r *http.Request, params api.GetStatsFairnessParams) {
+	report, err := h.statsService.GetFairness(r.Context(), params)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, report); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostSubscriptionsAdd подписывает команду на исходящие вебхуки о событиях ее PR
+func (h *Handler) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request) {
+	var body api.PostSubscriptionsAddJSONRequestBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	subscription, err := h.subscriptions.CreateSubscription(r.Context(), api.WebhookSubscription{
+		TeamName:   body.TeamName,
+		Url:        body.Url,
+		Secret:     body.Secret,
+		EventTypes: body.EventTypes,
+	})
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		Subscription *api.WebhookSubscription `json:"subscription"`
+	}{
+		Subscription: subscription,
+	}
+
+	if err := utils.WriteJson(w, http.StatusCreated, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// GetSubscriptionsList возвращает подписки команды (без секретов)
+func (h *Handler) GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params api.GetSubscriptionsListParams) {
+	teamName := params.TeamName
+
+	subscriptions, err := h.subscriptions.ListSubscriptions(r.Context(), teamName)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		TeamName      string                    `json:"team_name"`
+		Subscriptions []api.WebhookSubscription `json:"subscriptions"`
+	}{
+		TeamName:      teamName,
+		Subscriptions: subscriptions,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostSubscriptionsDelete удаляет подписку вместе с ее недоставленными событиями
+func (h *Handler) PostSubscriptionsDelete(w http.ResponseWriter, r *http.Request) {
+	var body api.PostSubscriptionsDeleteJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.subscriptions.DeleteSubscription(r.Context(), body.SubscriptionId); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetSubscriptionsDeadLetters возвращает события подписки, которые так и не удалось доставить
+func (h *Handler) GetSubscriptionsDeadLetters(w http.ResponseWriter, r *http.Request, params api.GetSubscriptionsDeadLettersParams) {
+	subscriptionID := params.SubscriptionId
+
+	deadLetters, err := h.subscriptions.ListDeadLetters(r.Context(), subscriptionID)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	response := struct {
+		SubscriptionID string           `json:"subscription_id"`
+		DeadLetters    []api.DeadLetter `json:"dead_letters"`
+	}{
+		SubscriptionID: subscriptionID,
+		DeadLetters:    deadLetters,
+	}
+
+	if err := utils.WriteJson(w, http.StatusOK, response); err != nil {
+		h.handleError(w, r, err)
+	}
+}
+
+// PostSubscriptionsRedeliver ставит недоставленное событие в очередь повторно
+func (h *Handler) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request) {
+	var body api.PostSubscriptionsRedeliverJSONBody
+	if err := utils.ParseJson(r, &body); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.subscriptions.RedeliverDeadLetter(r.Context(), body.DeadLetterId); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
 // GetHealth проверяет работоспособность сервиса
 func (h *Handler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	if err := utils.WriteJson(w, http.StatusOK, map[string]string{"status": "ok"}); err != nil {
 		h.handleError(w, r, err)
 	}
 }
-
-// Проверка соответствия интерфейсу во время компиляции
-var _ api.ServerInterface = (*Handler)(nil)
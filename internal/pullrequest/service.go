@@ -3,14 +3,18 @@ package pullrequest
 import (
 	"context"
 	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/internal/tracing"
 	"deplagene/avito-tech-internship/types"
 	"deplagene/avito-tech-internship/utils"
+	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand"
 	"slices"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,6 +22,7 @@ type Service struct {
 	prRepo   types.PullRequestRepository
 	userRepo types.UserRepository
 	teamRepo types.TeamRepository
+	events   types.EventRecorder
 	db       *pgxpool.Pool
 	logger   *slog.Logger
 }
@@ -26,6 +31,7 @@ func NewService(
 	prRepo types.PullRequestRepository,
 	userRepo types.UserRepository,
 	teamRepo types.TeamRepository,
+	events types.EventRecorder,
 	db *pgxpool.Pool,
 	logger *slog.Logger,
 ) *Service {
@@ -33,31 +39,60 @@ func NewService(
 		prRepo:   prRepo,
 		userRepo: userRepo,
 		teamRepo: teamRepo,
+		events:   events,
 		db:       db,
 		logger:   logger,
 	}
 }
 
-// CreatePullRequest создает PR и автоматически назначает до 2 ревьюверов из команды автора.
-func (s *Service) CreatePullRequest(ctx context.Context, pr api.PullRequest) (*api.PullRequest, error) {
+// log возвращает логгер запроса из контекста, а вне HTTP-запроса — логгер сервиса.
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// CreatePullRequest создает PR и автоматически назначает ревьюверов из команды, к которой он отнесен.
+// Если автор состоит в нескольких командах, teamName обязателен; иначе берется единственная команда автора.
+// Число ревьюверов и стратегия выбора берутся из настроек команды с учетом наследования (по умолчанию 2, random).
+func (s *Service) CreatePullRequest(ctx context.Context, pr api.PullRequest, teamName string) (created *api.PullRequest, err error) {
 	const op = "pullrequest.service.CreatePullRequest"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	return s.createPullRequest(ctx, op, pr, teamName, false)
+}
+
+// CreateDraftPullRequest создает PR-черновик. Команда определяется так же, как в CreatePullRequest,
+// но ревьюверы назначаются только после SetDraft(false).
+func (s *Service) CreateDraftPullRequest(ctx context.Context, pr api.PullRequest, teamName string) (created *api.PullRequest, err error) {
+	const op = "pullrequest.service.CreateDraftPullRequest"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	return s.createPullRequest(ctx, op, pr, teamName, true)
+}
+
+func (s *Service) createPullRequest(ctx context.Context, op string, pr api.PullRequest, teamName string, draft bool) (created *api.PullRequest, err error) {
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	var strategy api.ReviewStrategy
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
+		} else if err = tx.Commit(ctx); err != nil {
+			created, err = nil, fmt.Errorf("%s: %w", op, err)
 		} else {
-			err = tx.Commit(ctx)
+			metrics.ReviewersAssigned(string(strategy), len(created.AssignedReviewers))
 		}
 	}()
 
@@ -77,50 +112,94 @@ func (s *Service) CreatePullRequest(ctx context.Context, pr api.PullRequest) (*a
 		return nil, types.ErrNotFound
 	}
 
-	candidates, err := s.userRepo.GetActiveUsersByTeam(ctx, tx, author.TeamName, author.UserId, 2)
+	authorTeams, err := s.userRepo.GetTeamsByUserID(ctx, tx, author.UserId)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	pr.AssignedReviewers = make([]string, 0, 2)
-	for _, candidate := range candidates {
-		pr.AssignedReviewers = append(pr.AssignedReviewers, candidate.UserId)
+	switch {
+	case teamName != "":
+		if !slices.Contains(authorTeams, teamName) {
+			return nil, fmt.Errorf("%s: author is not a member of team %s: %w", op, teamName, types.ErrInvalidInput)
+		}
+	case len(authorTeams) == 1:
+		teamName = authorTeams[0]
+	case len(authorTeams) > 1:
+		return nil, types.ErrTeamRequired
+	}
+
+	pr.AssignedReviewers = []string{}
+	if !draft {
+		pr.AssignedReviewers, strategy, err = s.pickReviewers(ctx, tx, teamName, author.UserId, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
 	}
 
 	pr.Status = api.PullRequestStatusOPEN
 	pr.CreatedAt = api.Ptr(time.Now())
 
-	if err := s.prRepo.Create(ctx, tx, pr); err != nil {
+	if err := s.prRepo.Create(ctx, tx, pr, teamName); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if draft {
+		if _, err := s.prRepo.SetDraft(ctx, tx, pr.PullRequestId, true); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
 
-	return &pr, nil
+	created = &pr
+	if err := s.events.Record(ctx, tx, api.DomainEvent{
+		Type:          api.DomainEventPRCREATED,
+		TeamName:      teamName,
+		PullRequestId: pr.PullRequestId,
+		PullRequest:   created,
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.recordAssignments(ctx, tx, pr.PullRequestId, pr.AssignedReviewers, teamName, strategy); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
 }
 
 // MergePullRequest помечает PR как MERGED.
-func (s *Service) MergePullRequest(ctx context.Context, prID string) (*api.PullRequest, error) {
+// Строка PR блокируется до конца транзакции, чтобы merge не гонялся с параллельным reassign.
+func (s *Service) MergePullRequest(ctx context.Context, prID string) (pr *api.PullRequest, err error) {
 	const op = "pullrequest.service.MergePullRequest"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	var merged bool
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
-		} else {
-			err = tx.Commit(ctx)
+		} else if err = tx.Commit(ctx); err != nil {
+			pr, err = nil, fmt.Errorf("%s: %w", op, err)
+		} else if merged {
+			metrics.Merged()
 		}
 	}()
 
-	pr, err := s.prRepo.GetByID(ctx, tx, prID)
+	if err = s.prRepo.LockByID(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err = s.prRepo.GetByID(ctx, tx, prID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -131,40 +210,286 @@ func (s *Service) MergePullRequest(ctx context.Context, prID string) (*api.PullR
 	if pr.Status == api.PullRequestStatusMERGED {
 		return pr, nil // ! если уже MERGED, просто возвращаем текущее состояние
 	}
+	if pr.Status == api.PullRequestStatusCLOSED {
+		return nil, types.ErrPRClosed
+	}
 
-	if err := s.prRepo.Merge(ctx, tx, prID); err != nil {
+	if err = s.prRepo.Merge(ctx, tx, prID); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	merged = true
 	pr.Status = api.PullRequestStatusMERGED
 	pr.MergedAt = api.Ptr(time.Now())
+	if err = s.recordStatusChange(ctx, tx, api.DomainEventPRMERGED, pr); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return pr, nil
+}
+
+// ClosePullRequest помечает открытый PR как CLOSED (закрыт без merge). Ревьюверы остаются в PR
+// для истории, но закрытые PR не учитываются в открытых ревью. Повторное закрытие ничего не меняет.
+func (s *Service) ClosePullRequest(ctx context.Context, prID string) (pr *api.PullRequest, err error) {
+	const op = "pullrequest.service.ClosePullRequest"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			pr, err = nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}()
+
+	if err = s.prRepo.LockByID(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err = s.prRepo.GetByID(ctx, tx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if pr == nil {
+		return nil, types.ErrNotFound
+	}
+
+	switch pr.Status {
+	case api.PullRequestStatusMERGED:
+		return nil, types.ErrPRMerged
+	case api.PullRequestStatusCLOSED:
+		return pr, nil
+	}
+
+	if err = s.prRepo.Close(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr.Status = api.PullRequestStatusCLOSED
+	if err = s.recordStatusChange(ctx, tx, api.DomainEventPRCLOSED, pr); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	return pr, nil
 }
 
-// ReassignReviewer переназначает конкретного ревьювера на другого из его команды.
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*api.PullRequest, string, error) {
+// ReopenPullRequest возвращает закрытый PR в статус OPEN с прежними ревьюверами.
+func (s *Service) ReopenPullRequest(ctx context.Context, prID string) (pr *api.PullRequest, err error) {
+	const op = "pullrequest.service.ReopenPullRequest"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			pr, err = nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}()
+
+	if err = s.prRepo.LockByID(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err = s.prRepo.GetByID(ctx, tx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if pr == nil {
+		return nil, types.ErrNotFound
+	}
+
+	switch pr.Status {
+	case api.PullRequestStatusMERGED:
+		return nil, types.ErrPRMerged
+	case api.PullRequestStatusOPEN:
+		return pr, nil
+	}
+
+	if err = s.prRepo.Reopen(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr.Status = api.PullRequestStatusOPEN
+	if err = s.recordStatusChange(ctx, tx, api.DomainEventPRREOPENED, pr); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return pr, nil
+}
+
+// SetDraft переводит PR в черновик и обратно. Черновик не занимает ревьюверов: при переводе
+// в черновик все они снимаются, а когда PR готов к ревью, назначаются заново по настройкам команды.
+func (s *Service) SetDraft(ctx context.Context, prID string, draft bool) (pr *api.PullRequest, err error) {
+	const op = "pullrequest.service.SetDraft"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var assigned []string
+	var strategy api.ReviewStrategy
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			pr, err = nil, fmt.Errorf("%s: %w", op, err)
+		} else {
+			metrics.ReviewersAssigned(string(strategy), len(assigned))
+		}
+	}()
+
+	if err = s.prRepo.LockByID(ctx, tx, prID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err = s.prRepo.GetByID(ctx, tx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if pr == nil {
+		return nil, types.ErrNotFound
+	}
+
+	switch pr.Status {
+	case api.PullRequestStatusMERGED:
+		return nil, types.ErrPRMerged
+	case api.PullRequestStatusCLOSED:
+		return nil, types.ErrPRClosed
+	}
+
+	changed, err := s.prRepo.SetDraft(ctx, tx, prID, draft)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !changed {
+		return pr, nil
+	}
+
+	teamName, err := s.prRepo.GetTeamByID(ctx, tx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if draft {
+		for _, reviewerID := range pr.AssignedReviewers {
+			if err = s.prRepo.RemoveReviewer(ctx, tx, prID, reviewerID); err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			event := api.ReviewEvent{
+				PullRequestId: prID,
+				UserId:        reviewerID,
+				Type:          api.ReviewEventUNASSIGNED,
+				TeamName:      teamName,
+			}
+			if err = s.prRepo.AddEvent(ctx, tx, event); err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			if err = s.events.Record(ctx, tx, api.DomainEvent{
+				Type:          api.DomainEventREVIEWERUNASSIGNED,
+				TeamName:      teamName,
+				PullRequestId: prID,
+				ReviewerId:    reviewerID,
+			}); err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		pr.AssignedReviewers = []string{}
+		return pr, nil
+	}
+
+	assigned, strategy, err = s.pickReviewers(ctx, tx, teamName, pr.AuthorId, pr.AssignedReviewers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, reviewerID := range assigned {
+		if err = s.prRepo.AddReviewer(ctx, tx, prID, reviewerID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if err = s.recordAssignments(ctx, tx, prID, assigned, teamName, strategy); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr.AssignedReviewers = append(pr.AssignedReviewers, assigned...)
+	return pr, nil
+}
+
+// ReassignReviewer переназначает конкретного ревьювера на другого из команды, к которой отнесен PR.
+// Если в команде замены нет, она ищется среди участников команд-предков.
+// Строка PR блокируется до конца транзакции: параллельные reassign/merge одного PR
+// выполняются строго по очереди, поэтому набор ревьюверов не может разъехаться.
+func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (pr *api.PullRequest, newReviewerID string, err error) {
 	const op = "pullrequest.service.ReassignReviewer"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+	var poolTeam string
+	var strategy api.ReviewStrategy
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
+			// Транзакция откатана, поэтому NO_CANDIDATE пишется отдельно, уже без блокировки PR
+			if errors.Is(err, types.ErrNoCandidate) {
+				s.recordNoCandidate(ctx, prID, oldReviewerID, poolTeam)
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			pr, newReviewerID, err = nil, "", fmt.Errorf("%s: %w", op, err)
 		} else {
-			err = tx.Commit(ctx)
+			metrics.ReviewerReassigned()
+			metrics.ReviewersAssigned(string(strategy), 1)
 		}
 	}()
 
-	pr, err := s.prRepo.GetByID(ctx, tx, prID)
+	if err = s.prRepo.LockByID(ctx, tx, prID); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	pr, err = s.prRepo.GetByID(ctx, tx, prID)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
@@ -175,45 +500,36 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 	if pr.Status == api.PullRequestStatusMERGED {
 		return nil, "", types.ErrPRMerged
 	}
+	if pr.Status == api.PullRequestStatusCLOSED {
+		return nil, "", types.ErrPRClosed
+	}
 
 	isAssigned := slices.Contains(pr.AssignedReviewers, oldReviewerID)
 	if !isAssigned {
 		return nil, "", types.ErrNotAssigned
 	}
 
-	oldReviewerTeam, err := s.userRepo.GetTeamByUserID(ctx, tx, oldReviewerID)
+	poolTeam, err = s.replacementTeam(ctx, tx, prID, oldReviewerID)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	var currentReviewers []string
-	currentReviewers = append(currentReviewers, pr.AssignedReviewers...)
-
-	allTeamMembers, err := s.userRepo.GetActiveUsersByTeam(ctx, tx, oldReviewerTeam, oldReviewerID, 0)
+	var newReviewer *api.User
+	newReviewer, strategy, err = s.pickReplacement(ctx, tx, pr, oldReviewerID, poolTeam)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-
-	var replacementCandidates []api.User
-	for _, member := range allTeamMembers {
-		isCurrentReviewer := slices.Contains(currentReviewers, member.UserId)
-		if member.UserId != oldReviewerID && member.UserId != pr.AuthorId && !isCurrentReviewer {
-			replacementCandidates = append(replacementCandidates, member)
-		}
-	}
-
-	if len(replacementCandidates) == 0 {
+	if newReviewer == nil {
 		return nil, "", types.ErrNoCandidate
 	}
 
-	source := rand.NewSource(time.Now().UnixNano())
-	r := rand.New(source)
-	newReviewer := replacementCandidates[r.Intn(len(replacementCandidates))]
-
-	if err := s.prRepo.RemoveReviewer(ctx, tx, prID, oldReviewerID); err != nil {
+	if err = s.prRepo.RemoveReviewer(ctx, tx, prID, oldReviewerID); err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
-	if err := s.prRepo.AddReviewer(ctx, tx, prID, newReviewer.UserId); err != nil {
+	if err = s.prRepo.AddReviewer(ctx, tx, prID, newReviewer.UserId); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.recordReassignment(ctx, tx, prID, oldReviewerID, newReviewer, poolTeam, strategy); err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -227,10 +543,284 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 	return pr, newReviewer.UserId, nil
 }
 
+// ReassignOpenReviews снимает пользователя с его открытых ревью в PR команды teamName и, где это возможно,
+// назначает вместо него другого активного участника команды PR (или ее предков).
+// Пустой teamName означает PR всех команд. Если замены нет, PR остается с меньшим числом ревьюверов.
+// Метрики переназначений откладываются через metrics.AfterCommit: транзакцию вызывающий должен
+// открыть с metrics.WithAfterCommit.
+func (s *Service) ReassignOpenReviews(ctx context.Context, tx pgx.Tx, userID, teamName string) (err error) {
+	const op = "pullrequest.service.ReassignOpenReviews"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	prIDs, err := s.prRepo.GetOpenIDsByReviewer(ctx, tx, userID, teamName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, prID := range prIDs {
+		if err := s.prRepo.LockByID(ctx, tx, prID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		pr, err := s.prRepo.GetByID(ctx, tx, prID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if pr == nil || pr.Status != api.PullRequestStatusOPEN || !slices.Contains(pr.AssignedReviewers, userID) {
+			continue
+		}
+
+		poolTeam, err := s.prRepo.GetTeamByID(ctx, tx, prID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if poolTeam == "" {
+			poolTeam = teamName
+		}
+
+		newReviewer, strategy, err := s.pickReplacement(ctx, tx, pr, userID, poolTeam)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := s.prRepo.RemoveReviewer(ctx, tx, prID, userID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if newReviewer == nil {
+			s.log(ctx).WarnContext(ctx, "no replacement reviewer, pr left with fewer reviewers", "pull_request_id", prID, "user_id", userID)
+		} else if err := s.prRepo.AddReviewer(ctx, tx, prID, newReviewer.UserId); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := s.recordReassignment(ctx, tx, prID, userID, newReviewer, poolTeam, strategy); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Транзакцией владеет вызывающий, поэтому счетчики обновятся после ее коммита
+		replaced := newReviewer != nil
+		metrics.AfterCommit(ctx, func() {
+			metrics.ReviewerReassigned()
+			if replaced {
+				metrics.ReviewersAssigned(string(strategy), 1)
+			} else {
+				metrics.NoCandidate()
+			}
+		})
+	}
+
+	return nil
+}
+
+// replacementTeam возвращает команду, из которой выбирается замена ревьюверу: команду PR,
+// а для PR без команды — основную команду самого ревьювера.
+func (s *Service) replacementTeam(ctx context.Context, tx pgx.Tx, prID, reviewerID string) (string, error) {
+	teamName, err := s.prRepo.GetTeamByID(ctx, tx, prID)
+	if err != nil || teamName != "" {
+		return teamName, err
+	}
+
+	reviewerTeams, err := s.userRepo.GetTeamsByUserID(ctx, tx, reviewerID)
+	if err != nil || len(reviewerTeams) == 0 {
+		return "", err
+	}
+	return reviewerTeams[0], nil
+}
+
+// pickReplacement выбирает активного участника команды teamName, который не является автором PR
+// и еще не назначен на него. Если в команде замены нет, кандидаты ищутся среди участников
+// команд-предков, от ближайшей к корню. Возвращает nil, если кандидатов нет нигде.
+// Вместе с кандидатом возвращается стратегия, по которой он выбран.
+func (s *Service) pickReplacement(ctx context.Context, tx pgx.Tx, pr *api.PullRequest, oldReviewerID, teamName string) (*api.User, api.ReviewStrategy, error) {
+	const op = "pullrequest.service.pickReplacement"
+
+	settings, err := s.teamRepo.GetEffectiveSettings(ctx, tx, teamName)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	ancestors, err := s.teamRepo.GetAncestors(ctx, tx, teamName)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, poolTeam := range append([]string{teamName}, ancestors...) {
+		members, err := s.getCandidates(ctx, tx, poolTeam, oldReviewerID, 0, settings.Strategy)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Кандидаты уже упорядочены стратегией, поэтому берем первого подходящего
+		for _, member := range members {
+			if member.UserId != pr.AuthorId && !slices.Contains(pr.AssignedReviewers, member.UserId) {
+				if poolTeam != teamName {
+					s.log(ctx).InfoContext(ctx, "reviewer escalated to parent team", "pull_request_id", pr.PullRequestId, "team", teamName, "escalation_team", poolTeam)
+				}
+				return &member, settings.Strategy, nil
+			}
+		}
+	}
+
+	return nil, settings.Strategy, nil
+}
+
+// pickReviewers выбирает недостающих ревьюверов PR из команды teamName по ее настройкам
+// (число ревьюверов и стратегия с учетом наследования). assigned — уже назначенные ревьюверы.
+// PR без команды остается без ревьюверов.
+func (s *Service) pickReviewers(ctx context.Context, tx pgx.Tx, teamName, authorID string, assigned []string) ([]string, api.ReviewStrategy, error) {
+	const op = "pullrequest.service.pickReviewers"
+
+	settings, err := s.teamRepo.GetEffectiveSettings(ctx, tx, teamName)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	need := settings.ReviewerCount - len(assigned)
+	reviewers := make([]string, 0, max(need, 0))
+	if teamName == "" || need <= 0 {
+		return reviewers, settings.Strategy, nil
+	}
+
+	// Если часть ревьюверов уже назначена, берем всех кандидатов и пропускаем назначенных
+	limit := need
+	if len(assigned) > 0 {
+		limit = 0
+	}
+	candidates, err := s.getCandidates(ctx, tx, teamName, authorID, limit, settings.Strategy)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	for _, candidate := range candidates {
+		if len(reviewers) == need {
+			break
+		}
+		if !slices.Contains(assigned, candidate.UserId) {
+			reviewers = append(reviewers, candidate.UserId)
+		}
+	}
+
+	return reviewers, settings.Strategy, nil
+}
+
+// recordAssignments записывает в историю назначение ревьюверов reviewerIDs на PR
+// и публикует reviewer.assigned для каждого из них.
+func (s *Service) recordAssignments(ctx context.Context, tx pgx.Tx, prID string, reviewerIDs []string, teamName string, strategy api.ReviewStrategy) error {
+	for _, reviewerID := range reviewerIDs {
+		event := api.ReviewEvent{
+			PullRequestId: prID,
+			UserId:        reviewerID,
+			Type:          api.ReviewEventASSIGNED,
+			TeamName:      teamName,
+			Strategy:      strategy,
+		}
+		if err := s.prRepo.AddEvent(ctx, tx, event); err != nil {
+			return err
+		}
+		if err := s.events.Record(ctx, tx, api.DomainEvent{
+			Type:          api.DomainEventREVIEWERASSIGNED,
+			TeamName:      teamName,
+			PullRequestId: prID,
+			ReviewerId:    reviewerID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordStatusChange публикует смену статуса PR вместе с его текущим состоянием.
+func (s *Service) recordStatusChange(ctx context.Context, tx pgx.Tx, eventType api.DomainEventType, pr *api.PullRequest) error {
+	teamName, err := s.prRepo.GetTeamByID(ctx, tx, pr.PullRequestId)
+	if err != nil {
+		return err
+	}
+	return s.events.Record(ctx, tx, api.DomainEvent{
+		Type:          eventType,
+		TeamName:      teamName,
+		PullRequestId: pr.PullRequestId,
+		PullRequest:   pr,
+	})
+}
+
+// recordNoCandidate записывает событие NO_CANDIDATE в отдельной транзакции.
+// Ошибка записи только логируется: она не должна подменять ответ клиенту.
+func (s *Service) recordNoCandidate(ctx context.Context, prID, reviewerID, teamName string) {
+	metrics.NoCandidate()
+
+	event := api.ReviewEvent{
+		PullRequestId: prID,
+		UserId:        reviewerID,
+		Type:          api.ReviewEventNOCANDIDATE,
+		TeamName:      teamName,
+	}
+
+	err := pgx.BeginFunc(ctx, s.db, func(tx pgx.Tx) error {
+		return s.prRepo.AddEvent(ctx, tx, event)
+	})
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to record no candidate event", "pull_request_id", prID, utils.Err(err))
+	}
+}
+
+// recordReassignment записывает в историю снятие ревьювера с PR и назначение замены,
+// а если замены нет — событие NO_CANDIDATE. Подписчикам уходит reviewer.reassigned.
+func (s *Service) recordReassignment(ctx context.Context, tx pgx.Tx, prID, oldReviewerID string, newReviewer *api.User, teamName string, strategy api.ReviewStrategy) error {
+	events := []api.ReviewEvent{{
+		PullRequestId: prID,
+		UserId:        oldReviewerID,
+		Type:          api.ReviewEventUNASSIGNED,
+		TeamName:      teamName,
+	}}
+	if newReviewer == nil {
+		events = append(events, api.ReviewEvent{
+			PullRequestId: prID,
+			UserId:        oldReviewerID,
+			Type:          api.ReviewEventNOCANDIDATE,
+			TeamName:      teamName,
+		})
+	} else {
+		events = append(events, api.ReviewEvent{
+			PullRequestId: prID,
+			UserId:        newReviewer.UserId,
+			Type:          api.ReviewEventASSIGNED,
+			TeamName:      teamName,
+			Strategy:      strategy,
+		})
+	}
+
+	for _, event := range events {
+		if err := s.prRepo.AddEvent(ctx, tx, event); err != nil {
+			return err
+		}
+	}
+
+	reassigned := api.DomainEvent{
+		Type:          api.DomainEventREVIEWERREASSIGNED,
+		TeamName:      teamName,
+		PullRequestId: prID,
+		OldReviewerId: oldReviewerID,
+	}
+	if newReviewer != nil {
+		reassigned.ReviewerId = newReviewer.UserId
+	}
+	return s.events.Record(ctx, tx, reassigned)
+}
+
+// getCandidates возвращает активных участников команды в порядке, заданном стратегией.
+func (s *Service) getCandidates(ctx context.Context, tx pgx.Tx, teamName, excludeUserID string, limit int, strategy api.ReviewStrategy) ([]api.User, error) {
+	if strategy == api.ReviewStrategyLEASTLOADED {
+		return s.userRepo.GetLeastLoadedActiveUsersByTeam(ctx, tx, teamName, excludeUserID, limit)
+	}
+	return s.userRepo.GetActiveUsersByTeam(ctx, tx, teamName, excludeUserID, limit)
+}
+
 // GetPullRequestsByReviewer возвращает PR'ы, где пользователь назначен ревьювером.
 func (s *Service) GetPullRequestsByReviewer(ctx context.Context, userID string) (prs []api.PullRequestShort, err error) {
 	const op = "pullrequest.service.GetPullRequestsByReviewer"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		err = fmt.Errorf("%s: %w", op, err)
@@ -239,12 +829,12 @@ func (s *Service) GetPullRequestsByReviewer(ctx context.Context, userID string)
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
 		} else {
 			err = tx.Commit(ctx)
@@ -252,28 +842,27 @@ func (s *Service) GetPullRequestsByReviewer(ctx context.Context, userID string)
 	}()
 
 	user, err := s.userRepo.GetByID(ctx, tx, userID)
-	s.logger.Info("GetPullRequestsByReviewer: after GetByID", "error", err)
 	if err != nil {
 		err = fmt.Errorf("%s: %w", op, err)
-		s.logger.Info("GetPullRequestsByReviewer: after GetByID error wrap", "error", err)
 		return
 	}
 	if user == nil {
+		s.log(ctx).DebugContext(ctx, "reviewer not found", "op", op, "user_id", userID)
 		err = types.ErrNotFound
-		s.logger.Info("GetPullRequestsByReviewer: user not found", "error", err)
 		return
 	}
 
 	prs, err = s.prRepo.GetByReviewer(ctx, tx, userID)
-	s.logger.Info("GetPullRequestsByReviewer: after GetByReviewer", "error", err)
 	if err != nil {
 		err = fmt.Errorf("%s: %w", op, err)
-		s.logger.Info("GetPullRequestsByReviewer: after GetByReviewer error wrap", "error", err)
 		return
 	}
-	s.logger.Info("GetPullRequestsByReviewer: before return", "error", err)
+	s.log(ctx).DebugContext(ctx, "pull requests by reviewer loaded", "op", op, "user_id", userID, "count", len(prs))
 	return
 }
 
 // Проверка соответствия интерфейсу во время компиляции
-var _ types.PullRequestService = (*Service)(nil)
+var (
+	_ types.PullRequestService = (*Service)(nil)
+	_ types.ReviewReassigner   = (*Service)(nil)
+)
@@ -0,0 +1,211 @@
+package pullrequest_test
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/testdb"
+	"deplagene/avito-tech-internship/internal/user"
+	"deplagene/avito-tech-internship/types"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TestReassignMergeRace гоняет параллельные reassign и merge одного PR и проверяет инварианты ревьюверов:
+// их не больше, чем задано командой, нет дублей, автор не становится ревьювером,
+// а после merge ни один reassign не проходит.
+func TestReassignMergeRace(t *testing.T) {
+	pool := testdb.New(t)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	teamRepo := team.NewTeamRepository(pool)
+	userRepo := user.NewUserRepository(pool)
+	recorder := events.NewRecorder()
+	prService := pullrequest.NewService(pullrequest.NewPullRequestRepository(pool), userRepo, teamRepo, recorder, pool, logger)
+	teamService := team.NewService(teamRepo, userRepo, prService, recorder, pool, logger)
+
+	const (
+		teamName      = "backend"
+		authorID      = "author"
+		reviewerCount = 2
+		workers       = 16
+		attempts      = 40
+	)
+
+	members := []api.TeamMember{{UserId: authorID, Username: authorID, IsActive: true}}
+	for i := range 8 {
+		id := fmt.Sprintf("u%d", i)
+		members = append(members, api.TeamMember{UserId: id, Username: id, IsActive: true})
+	}
+	if _, err := teamService.CreateTeam(ctx, api.Team{TeamName: teamName, Members: members}); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	if _, err := teamService.UpdateTeamSettings(ctx, teamName, api.TeamSettings{ReviewerCount: api.Ptr(reviewerCount)}); err != nil {
+		t.Fatalf("update team settings: %v", err)
+	}
+
+	const prID = "pr-race"
+	created, err := prService.CreatePullRequest(ctx, api.PullRequest{
+		PullRequestId:   prID,
+		PullRequestName: "race",
+		AuthorId:        authorID,
+	}, "")
+	if err != nil {
+		t.Fatalf("create pull request: %v", err)
+	}
+	checkReviewers(t, pool, prID, authorID, reviewerCount)
+	if len(created.AssignedReviewers) != reviewerCount {
+		t.Fatalf("created pr has %d reviewers, want %d", len(created.AssignedReviewers), reviewerCount)
+	}
+
+	var (
+		// merged выставляется после коммита merge: reassign, начатый позже, обязан получить ErrPRMerged
+		merged          atomic.Bool
+		mergedReviewers []string
+		reassigned      atomic.Int64
+		wg              sync.WaitGroup
+	)
+	start := make(chan struct{})
+
+	for w := range workers {
+		wg.Go(func() {
+			<-start
+			for i := range attempts {
+				// Один из воркеров мержит PR в середине прогона
+				if w == 0 && i == attempts/2 {
+					pr, err := prService.MergePullRequest(ctx, prID)
+					if err != nil {
+						t.Errorf("merge: %v", err)
+						return
+					}
+					mergedReviewers = slices.Clone(pr.AssignedReviewers)
+					merged.Store(true)
+					continue
+				}
+
+				current, err := prService.GetPullRequestsByReviewer(ctx, authorID)
+				if err != nil {
+					t.Errorf("get reviews: %v", err)
+					return
+				}
+				if len(current) > 0 {
+					t.Errorf("author is reviewer of %v", current)
+					return
+				}
+
+				startedAfterMerge := merged.Load()
+				oldReviewer := fmt.Sprintf("u%d", rand.IntN(8))
+				_, newReviewer, err := prService.ReassignReviewer(ctx, prID, oldReviewer)
+				switch {
+				case err == nil:
+					if startedAfterMerge {
+						t.Errorf("reassign %s -> %s succeeded after merge", oldReviewer, newReviewer)
+						return
+					}
+					if newReviewer == authorID {
+						t.Errorf("author assigned as reviewer")
+						return
+					}
+					reassigned.Add(1)
+				case errors.Is(err, types.ErrPRMerged):
+				case !startedAfterMerge && (errors.Is(err, types.ErrNotAssigned) || errors.Is(err, types.ErrNoCandidate)):
+				case startedAfterMerge:
+					t.Errorf("reassign after merge: got %v, want ErrPRMerged", err)
+					return
+				default:
+					t.Errorf("reassign %s: %v", oldReviewer, err)
+					return
+				}
+			}
+		})
+	}
+
+	// Пока идут воркеры, инварианты проверяются на промежуточных состояниях
+	done := make(chan struct{})
+	var checker sync.WaitGroup
+	checker.Go(func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				checkReviewers(t, pool, prID, authorID, reviewerCount)
+			}
+		}
+	})
+
+	close(start)
+	wg.Wait()
+	close(done)
+	checker.Wait()
+
+	if !merged.Load() {
+		t.Fatal("pull request was not merged")
+	}
+	if reassigned.Load() == 0 {
+		t.Error("no reassign succeeded, the race was not exercised")
+	}
+
+	final := checkReviewers(t, pool, prID, authorID, reviewerCount)
+	slices.Sort(final)
+	slices.Sort(mergedReviewers)
+	if !slices.Equal(final, mergedReviewers) {
+		t.Errorf("reviewers changed after merge: %v, at merge %v", final, mergedReviewers)
+	}
+
+	if _, _, err := prService.ReassignReviewer(ctx, prID, final[0]); !errors.Is(err, types.ErrPRMerged) {
+		t.Errorf("reassign of merged pr: got %v, want ErrPRMerged", err)
+	}
+}
+
+// checkReviewers читает строки reviewers напрямую, в обход агрегации в запросах сервиса,
+// проверяет инварианты и возвращает текущих ревьюверов.
+func checkReviewers(t *testing.T, pool *pgxpool.Pool, prID, authorID string, maxReviewers int) []string {
+	t.Helper()
+
+	rows, err := pool.Query(context.Background(), `SELECT user_id FROM reviewers WHERE pull_request_id = $1`, prID)
+	if err != nil {
+		t.Errorf("query reviewers: %v", err)
+		return nil
+	}
+	defer rows.Close()
+
+	var reviewers []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Errorf("scan reviewer: %v", err)
+			return nil
+		}
+		reviewers = append(reviewers, id)
+	}
+	if err := rows.Err(); err != nil {
+		t.Errorf("read reviewers: %v", err)
+		return nil
+	}
+
+	if len(reviewers) > maxReviewers {
+		t.Errorf("pr has %d reviewers %v, team allows %d", len(reviewers), reviewers, maxReviewers)
+	}
+	if slices.Contains(reviewers, authorID) {
+		t.Errorf("author %s is a reviewer: %v", authorID, reviewers)
+	}
+	unique := slices.Clone(reviewers)
+	slices.Sort(unique)
+	if len(slices.Compact(unique)) != len(reviewers) {
+		t.Errorf("duplicate reviewers: %v", reviewers)
+	}
+	return reviewers
+}
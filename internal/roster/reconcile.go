@@ -0,0 +1,259 @@
+package roster
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TeamService — операции над командами, которые нужны сверке.
+type TeamService interface {
+	types.TeamService
+	types.TeamTxService
+}
+
+// UserService — операции над пользователями, которые нужны сверке.
+type UserService interface {
+	types.UserService
+	types.UserTxService
+}
+
+// Action — вид изменения в плане сверки.
+type Action string
+
+const (
+	ActionCreateTeam   Action = "create-team"
+	ActionCreateUser   Action = "create-user"
+	ActionAddMember    Action = "add-member"
+	ActionMoveUser     Action = "move-user"
+	ActionRemoveMember Action = "remove-member"
+	ActionSetActive    Action = "set-active"
+	ActionDeleteTeam   Action = "delete-team"
+)
+
+// Step — одно изменение, которое нужно применить, чтобы БД совпала с файлом.
+type Step struct {
+	Action   Action
+	Team     string
+	FromTeam string
+	Member   api.TeamMember
+}
+
+func (s Step) String() string {
+	switch s.Action {
+	case ActionCreateTeam:
+		return fmt.Sprintf("+ team %s", s.Team)
+	case ActionCreateUser:
+		return fmt.Sprintf("+ user %s (%s) -> %s, active=%t", s.Member.UserId, s.Member.Username, s.Team, s.Member.IsActive)
+	case ActionAddMember:
+		return fmt.Sprintf("+ member %s -> %s", s.Member.UserId, s.Team)
+	case ActionMoveUser:
+		return fmt.Sprintf("~ move %s: %s -> %s", s.Member.UserId, s.FromTeam, s.Team)
+	case ActionRemoveMember:
+		return fmt.Sprintf("- member %s from %s", s.Member.UserId, s.Team)
+	case ActionSetActive:
+		return fmt.Sprintf("~ user %s: active=%t", s.Member.UserId, s.Member.IsActive)
+	case ActionDeleteTeam:
+		return fmt.Sprintf("- team %s", s.Team)
+	default:
+		return string(s.Action)
+	}
+}
+
+// Plan — упорядоченный список изменений. Пустой план означает, что БД уже совпадает с файлом.
+type Plan struct {
+	Steps []Step
+}
+
+type Reconciler struct {
+	teamService TeamService
+	userService UserService
+	db          *pgxpool.Pool
+	logger      *slog.Logger
+}
+
+func NewReconciler(teamService TeamService, userService UserService, db *pgxpool.Pool, logger *slog.Logger) *Reconciler {
+	return &Reconciler{
+		teamService: teamService,
+		userService: userService,
+		db:          db,
+		logger:      logger,
+	}
+}
+
+// Plan сравнивает файл с текущим состоянием БД.
+// Сверка затрагивает только команды, перечисленные в файле; остальные команды
+// удаляются лишь при prune. Имя пользователя из файла используется только при его создании.
+func (r *Reconciler) Plan(ctx context.Context, roster *Roster, prune bool) (*Plan, error) {
+	const op = "roster.Reconciler.Plan"
+
+	existingTeams, err := r.teamService.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	plan := &Plan{}
+	managed := make(map[string]bool, len(roster.Teams))
+	currentMembers := make(map[string][]api.TeamMember, len(roster.Teams))
+	for _, team := range roster.Teams {
+		managed[team.Name] = true
+		if !slices.Contains(existingTeams, team.Name) {
+			plan.Steps = append(plan.Steps, Step{Action: ActionCreateTeam, Team: team.Name})
+			continue
+		}
+
+		current, err := r.teamService.GetTeam(ctx, team.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		currentMembers[team.Name] = current.Members
+	}
+
+	// Желаемые команды каждого пользователя в порядке их появления в файле
+	var userIDs []string
+	desired := make(map[string][]string)
+	members := make(map[string]Member)
+	for _, team := range roster.Teams {
+		for _, member := range team.Members {
+			if _, ok := members[member.UserID]; !ok {
+				userIDs = append(userIDs, member.UserID)
+				members[member.UserID] = member
+			}
+			desired[member.UserID] = append(desired[member.UserID], team.Name)
+		}
+	}
+
+	for _, userID := range userIDs {
+		steps, err := r.planUser(ctx, members[userID], desired[userID], managed)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		plan.Steps = append(plan.Steps, steps...)
+	}
+
+	// Участники управляемых команд, которых нет в файле вовсе
+	for _, team := range roster.Teams {
+		for _, member := range currentMembers[team.Name] {
+			if _, ok := members[member.UserId]; !ok {
+				plan.Steps = append(plan.Steps, Step{Action: ActionRemoveMember, Team: team.Name, Member: member})
+			}
+		}
+	}
+
+	if prune {
+		for _, name := range existingTeams {
+			if !managed[name] {
+				plan.Steps = append(plan.Steps, Step{Action: ActionDeleteTeam, Team: name})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// planUser приводит членство и флаг активности одного пользователя к описанию в файле.
+// Если пользователь покидает основную команду и вступает в новую, это оформляется как переезд,
+// чтобы его открытые ревью переназначились так же, как при /users/move.
+func (r *Reconciler) planUser(ctx context.Context, member Member, teams []string, managed map[string]bool) ([]Step, error) {
+	ref := api.TeamMember{UserId: member.UserID, Username: member.Username, IsActive: member.Active()}
+
+	profile, err := r.userService.GetUser(ctx, member.UserID)
+	if errors.Is(err, types.ErrNotFound) {
+		steps := []Step{{Action: ActionCreateUser, Team: teams[0], Member: ref}}
+		for _, team := range teams[1:] {
+			steps = append(steps, Step{Action: ActionAddMember, Team: team, Member: ref})
+		}
+		return steps, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var toAdd, toRemove []string
+	for _, team := range teams {
+		if !slices.Contains(profile.Teams, team) {
+			toAdd = append(toAdd, team)
+		}
+	}
+	for _, team := range profile.Teams {
+		if managed[team] && !slices.Contains(teams, team) {
+			toRemove = append(toRemove, team)
+		}
+	}
+
+	var steps []Step
+	if len(toAdd) > 0 && slices.Contains(toRemove, profile.TeamName) {
+		steps = append(steps, Step{Action: ActionMoveUser, Team: toAdd[0], FromTeam: profile.TeamName, Member: ref})
+		toAdd = toAdd[1:]
+		toRemove = slices.DeleteFunc(toRemove, func(team string) bool { return team == profile.TeamName })
+	}
+	for _, team := range toAdd {
+		steps = append(steps, Step{Action: ActionAddMember, Team: team, Member: ref})
+	}
+	for _, team := range toRemove {
+		steps = append(steps, Step{Action: ActionRemoveMember, Team: team, Member: ref})
+	}
+	if profile.IsActive != ref.IsActive {
+		steps = append(steps, Step{Action: ActionSetActive, Member: ref})
+	}
+	return steps, nil
+}
+
+// Apply применяет план в одной транзакции: при ошибке любого шага изменения не сохраняются.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) (err error) {
+	const op = "roster.Reconciler.Apply"
+
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if rec := recover(); rec != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				r.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(rec)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				r.logger.Error("failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	for _, step := range plan.Steps {
+		switch step.Action {
+		case ActionCreateTeam:
+			_, err = r.teamService.CreateTeamTx(ctx, tx, api.Team{TeamName: step.Team, Members: []api.TeamMember{}})
+		case ActionCreateUser:
+			_, err = r.teamService.CreateTeamTx(ctx, tx, api.Team{TeamName: step.Team, Members: []api.TeamMember{step.Member}})
+		case ActionAddMember:
+			_, err = r.teamService.AddMemberTx(ctx, tx, step.Team, step.Member.UserId)
+		case ActionMoveUser:
+			_, err = r.userService.MoveUserTx(ctx, tx, step.Member.UserId, step.Team)
+		case ActionRemoveMember:
+			err = r.teamService.RemoveMemberTx(ctx, tx, step.Team, step.Member.UserId)
+		case ActionSetActive:
+			_, err = r.userService.SetUserIsActiveTx(ctx, tx, step.Member.UserId, step.Member.IsActive)
+		case ActionDeleteTeam:
+			err = r.teamService.DeleteTeamTx(ctx, tx, step.Team)
+		default:
+			err = fmt.Errorf("unknown action %q", step.Action)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", op, step, err)
+		}
+	}
+
+	return nil
+}
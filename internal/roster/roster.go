@@ -0,0 +1,105 @@
+package roster
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Roster — желаемый состав команд, который хранится в YAML-файле под git.
+//
+//	teams:
+//	  - name: backend
+//	    members:
+//	      - user_id: u1
+//	        username: Alice
+//	      - user_id: u2
+//	        username: Bob
+//	        is_active: false
+//
+// Пользователь может быть указан в нескольких командах; новому пользователю основной
+// назначается первая из них.
+type Roster struct {
+	Teams []Team `yaml:"teams"`
+}
+
+// Team — команда и ее участники.
+type Team struct {
+	Name    string   `yaml:"name"`
+	Members []Member `yaml:"members"`
+}
+
+// Member — участник команды. Если is_active не указан, пользователь считается активным.
+type Member struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active"`
+}
+
+// Active возвращает итоговый флаг активности участника.
+func (m Member) Active() bool {
+	return m.IsActive == nil || *m.IsActive
+}
+
+// Load читает и проверяет файл с составом команд.
+func Load(path string) (*Roster, error) {
+	const op = "roster.Load"
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var roster Roster
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&roster); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %s: %w", op, path, err)
+	}
+
+	if err := roster.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", op, path, err)
+	}
+	return &roster, nil
+}
+
+// validate проверяет, что команды не повторяются, а один и тот же пользователь
+// описан одинаково во всех командах, где он указан.
+func (r *Roster) validate() error {
+	teams := make(map[string]bool, len(r.Teams))
+	users := make(map[string]Member)
+
+	for _, team := range r.Teams {
+		if team.Name == "" {
+			return errors.New("team name is required")
+		}
+		if teams[team.Name] {
+			return fmt.Errorf("team %s is declared twice", team.Name)
+		}
+		teams[team.Name] = true
+
+		members := make(map[string]bool, len(team.Members))
+		for _, member := range team.Members {
+			if member.UserID == "" || member.Username == "" {
+				return fmt.Errorf("team %s: user_id and username are required", team.Name)
+			}
+			if members[member.UserID] {
+				return fmt.Errorf("team %s: user %s is listed twice", team.Name, member.UserID)
+			}
+			members[member.UserID] = true
+
+			if seen, ok := users[member.UserID]; ok {
+				if seen.Username != member.Username || seen.Active() != member.Active() {
+					return fmt.Errorf("user %s is described differently in several teams", member.UserID)
+				}
+				continue
+			}
+			users[member.UserID] = member
+		}
+	}
+	return nil
+}
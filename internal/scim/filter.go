@@ -0,0 +1,131 @@
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter — разобранный SCIM-фильтр. Поддерживается подмножество RFC 7644 §3.4.2.2:
+// сравнения "eq", объединенные через "and", например `userName eq "john" and active eq true`.
+// Ключи — имена атрибутов в нижнем регистре.
+type Filter map[string]string
+
+// ParseFilter разбирает строку фильтра. Пустая строка дает пустой фильтр.
+func ParseFilter(raw string) (Filter, error) {
+	filter := Filter{}
+	if strings.TrimSpace(raw) == "" {
+		return filter, nil
+	}
+
+	tokens, err := tokenize(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(tokens); {
+		if len(tokens)-i < 3 {
+			return nil, fmt.Errorf("incomplete expression in filter %q", raw)
+		}
+
+		attr, op, value := tokens[i], tokens[i+1], tokens[i+2]
+		if !strings.EqualFold(op, "eq") {
+			return nil, fmt.Errorf("unsupported operator %q, only eq is supported", op)
+		}
+		filter[strings.ToLower(attr)] = value
+		i += 3
+
+		if i < len(tokens) {
+			if !strings.EqualFold(tokens[i], "and") {
+				return nil, fmt.Errorf("unsupported logical operator %q, only and is supported", tokens[i])
+			}
+			i++
+			if i == len(tokens) {
+				return nil, fmt.Errorf("dangling and in filter %q", raw)
+			}
+		}
+	}
+
+	return filter, nil
+}
+
+// String возвращает строковое значение атрибута, если оно задано.
+func (f Filter) String(attr string) *string {
+	if v, ok := f[attr]; ok {
+		return &v
+	}
+	return nil
+}
+
+// Bool возвращает булево значение атрибута, если оно задано.
+func (f Filter) Bool(attr string) (*bool, error) {
+	v, ok := f[attr]
+	if !ok {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return nil, fmt.Errorf("attribute %s expects a boolean, got %q", attr, v)
+	}
+	return &b, nil
+}
+
+// Only проверяет, что фильтр не ссылается на атрибуты вне allowed.
+func (f Filter) Only(allowed ...string) error {
+	for attr := range f {
+		found := false
+		for _, a := range allowed {
+			if attr == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("filtering by %q is not supported", attr)
+		}
+	}
+	return nil
+}
+
+// tokenize разбивает фильтр на слова, снимая кавычки со строковых значений.
+func tokenize(raw string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		switch {
+		case c == '"':
+			flush()
+			end := i + 1
+			for ; end < len(raw) && raw[end] != '"'; end++ {
+				if raw[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(raw) {
+				return nil, fmt.Errorf("unterminated string in filter %q", raw)
+			}
+			value, err := strconv.Unquote(raw[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string in filter %q: %w", raw, err)
+			}
+			tokens = append(tokens, value)
+			i = end
+		case c == ' ' || c == '\t':
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+
+	return tokens, nil
+}
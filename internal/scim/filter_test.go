@@ -0,0 +1,46 @@
+package scim_test
+
+import (
+	"deplagene/avito-tech-internship/internal/scim"
+	"maps"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    scim.Filter
+		wantErr bool
+	}{
+		{name: "empty", raw: "  ", want: scim.Filter{}},
+		{name: "eq", raw: `userName eq "john"`, want: scim.Filter{"username": "john"}},
+		{name: "case-insensitive operators", raw: `UserName EQ "john" AND Active Eq true`, want: scim.Filter{"username": "john", "active": "true"}},
+		{name: "quoted value with spaces", raw: `displayName eq "Platform Team"`, want: scim.Filter{"displayname": "Platform Team"}},
+		{name: "escaped quote", raw: `userName eq "o\"brien"`, want: scim.Filter{"username": `o"brien`}},
+		{name: "and", raw: `externalId eq "00u1" and active eq false`, want: scim.Filter{"externalid": "00u1", "active": "false"}},
+		{name: "co is not supported", raw: `userName co "jo"`, wantErr: true},
+		{name: "sw is not supported", raw: `userName sw "jo"`, wantErr: true},
+		{name: "or is not supported", raw: `userName eq "a" or userName eq "b"`, wantErr: true},
+		{name: "incomplete expression", raw: `userName eq`, wantErr: true},
+		{name: "dangling and", raw: `userName eq "john" and`, wantErr: true},
+		{name: "unterminated string", raw: `userName eq "john`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scim.ParseFilter(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseFilter(%q) = %v, want error", tt.raw, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFilter(%q): %v", tt.raw, err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("ParseFilter(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package scim
+
+var (
+	// Все фильтры необязательны: NULL в параметре отключает соответствующее условие.
+	// Удаленные через SCIM пользователи не возвращаются.
+	listUsersQuery = `
+		SELECT u.user_id, u.username, COALESCE(u.external_id, ''), u.role, u.is_active,
+		       COUNT(*) OVER () AS total
+		FROM users u
+		WHERE u.scim_deleted_at IS NULL
+			AND ($1::text IS NULL OR u.user_id = $1)
+			AND ($2::text IS NULL OR lower(u.username) = lower($2))
+			AND ($3::text IS NULL OR u.external_id = $3)
+			AND ($4::boolean IS NULL OR u.is_active = $4)
+		ORDER BY u.user_id
+		OFFSET $5
+		LIMIT $6;
+	`
+
+	createUserQuery = `
+		INSERT INTO users (user_id, username, is_active, role, external_id)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'member'), NULLIF($5, ''));
+	`
+
+	updateUserQuery = `
+		UPDATE users
+		SET username = $2, is_active = $3, role = COALESCE(NULLIF($4, ''), 'member'), external_id = NULLIF($5, '')
+		WHERE user_id = $1;
+	`
+
+	markUserDeletedQuery = `
+		UPDATE users SET scim_deleted_at = NOW() WHERE user_id = $1;
+	`
+
+	getUserGroupsQuery = `
+		SELECT t.scim_id::text, t.team_name
+		FROM team_memberships m
+		JOIN teams t ON t.team_name = m.team_name
+		WHERE m.user_id = $1
+		ORDER BY t.team_name;
+	`
+
+	listGroupsQuery = `
+		SELECT t.scim_id::text, t.team_name, COALESCE(t.external_id, ''),
+		       COUNT(*) OVER () AS total
+		FROM teams t
+		WHERE ($1::text IS NULL OR t.scim_id::text = $1)
+			AND ($2::text IS NULL OR t.team_name = $2)
+			AND ($3::text IS NULL OR t.external_id = $3)
+		ORDER BY t.team_name
+		OFFSET $4
+		LIMIT $5;
+	`
+
+	getGroupMembersQuery = `
+		SELECT u.user_id, u.username
+		FROM team_memberships m
+		JOIN users u ON u.user_id = m.user_id
+		WHERE m.team_name = $1
+		ORDER BY u.user_id;
+	`
+
+	setGroupExternalIDQuery = `
+		UPDATE teams SET external_id = NULLIF($2, '') WHERE team_name = $1;
+	`
+)
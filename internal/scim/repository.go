@@ -0,0 +1,229 @@
+package scim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// userRecord — строка users в том виде, в котором она нужна SCIM.
+type userRecord struct {
+	UserID     string
+	Username   string
+	ExternalID string
+	Role       string
+	IsActive   bool
+}
+
+// groupRecord — строка teams в том виде, в котором она нужна SCIM.
+type groupRecord struct {
+	ScimID     string
+	TeamName   string
+	ExternalID string
+}
+
+// userFilter — условия поиска пользователей. nil-поля не участвуют в фильтрации.
+type userFilter struct {
+	UserID     *string
+	Username   *string
+	ExternalID *string
+	Active     *bool
+}
+
+// groupFilter — условия поиска групп. nil-поля не участвуют в фильтрации.
+type groupFilter struct {
+	ScimID     *string
+	TeamName   *string
+	ExternalID *string
+}
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// ListUsers возвращает страницу пользователей и общее число подходящих записей.
+func (r *Repository) ListUsers(ctx context.Context, tx pgx.Tx, filter userFilter, page Page) ([]userRecord, int, error) {
+	const op = "scim.repository.ListUsers"
+
+	rows, err := tx.Query(ctx, listUsersQuery, filter.UserID, filter.Username, filter.ExternalID, filter.Active, page.StartIndex-1, page.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []userRecord
+	var total int
+	for rows.Next() {
+		var u userRecord
+		if err := rows.Scan(&u.UserID, &u.Username, &u.ExternalID, &u.Role, &u.IsActive, &total); err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return users, total, nil
+}
+
+// GetUser возвращает пользователя по user_id или nil, если его нет.
+func (r *Repository) GetUser(ctx context.Context, tx pgx.Tx, userID string) (*userRecord, error) {
+	const op = "scim.repository.GetUser"
+
+	users, _, err := r.ListUsers(ctx, tx, userFilter{UserID: &userID}, Page{StartIndex: 1, Count: 1})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return &users[0], nil
+}
+
+// CreateUser создает пользователя без команды.
+func (r *Repository) CreateUser(ctx context.Context, tx pgx.Tx, u userRecord) error {
+	const op = "scim.repository.CreateUser"
+
+	if _, err := tx.Exec(ctx, createUserQuery, u.UserID, u.Username, u.IsActive, u.Role, u.ExternalID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// UpdateUser перезаписывает атрибуты пользователя, которыми управляет SCIM.
+func (r *Repository) UpdateUser(ctx context.Context, tx pgx.Tx, u userRecord) error {
+	const op = "scim.repository.UpdateUser"
+
+	if _, err := tx.Exec(ctx, updateUserQuery, u.UserID, u.Username, u.IsActive, u.Role, u.ExternalID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// MarkUserDeleted помечает пользователя удаленным через SCIM: после этого он не находится
+// ни ListUsers, ни GetUser.
+func (r *Repository) MarkUserDeleted(ctx context.Context, tx pgx.Tx, userID string) error {
+	const op = "scim.repository.MarkUserDeleted"
+
+	if _, err := tx.Exec(ctx, markUserDeletedQuery, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetUserGroups возвращает группы (команды) пользователя.
+func (r *Repository) GetUserGroups(ctx context.Context, tx pgx.Tx, userID string) ([]GroupRef, error) {
+	const op = "scim.repository.GetUserGroups"
+
+	rows, err := tx.Query(ctx, getUserGroupsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var groups []GroupRef
+	for rows.Next() {
+		var g GroupRef
+		if err := rows.Scan(&g.Value, &g.Display); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		groups = append(groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return groups, nil
+}
+
+// ListGroups возвращает страницу групп и общее число подходящих записей.
+func (r *Repository) ListGroups(ctx context.Context, tx pgx.Tx, filter groupFilter, page Page) ([]groupRecord, int, error) {
+	const op = "scim.repository.ListGroups"
+
+	rows, err := tx.Query(ctx, listGroupsQuery, filter.ScimID, filter.TeamName, filter.ExternalID, page.StartIndex-1, page.Count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var groups []groupRecord
+	var total int
+	for rows.Next() {
+		var g groupRecord
+		if err := rows.Scan(&g.ScimID, &g.TeamName, &g.ExternalID, &total); err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+		groups = append(groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return groups, total, nil
+}
+
+// GetGroup возвращает группу по scim_id или nil, если ее нет.
+func (r *Repository) GetGroup(ctx context.Context, tx pgx.Tx, scimID string) (*groupRecord, error) {
+	const op = "scim.repository.GetGroup"
+
+	groups, _, err := r.ListGroups(ctx, tx, groupFilter{ScimID: &scimID}, Page{StartIndex: 1, Count: 1})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return &groups[0], nil
+}
+
+// GetGroupByName возвращает группу по имени команды или nil, если ее нет.
+func (r *Repository) GetGroupByName(ctx context.Context, tx pgx.Tx, teamName string) (*groupRecord, error) {
+	const op = "scim.repository.GetGroupByName"
+
+	groups, _, err := r.ListGroups(ctx, tx, groupFilter{TeamName: &teamName}, Page{StartIndex: 1, Count: 1})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return &groups[0], nil
+}
+
+// GetGroupMembers возвращает участников команды.
+func (r *Repository) GetGroupMembers(ctx context.Context, tx pgx.Tx, teamName string) ([]MemberRef, error) {
+	const op = "scim.repository.GetGroupMembers"
+
+	rows, err := tx.Query(ctx, getGroupMembersQuery, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	members := []MemberRef{}
+	for rows.Next() {
+		var m MemberRef
+		if err := rows.Scan(&m.Value, &m.Display); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return members, nil
+}
+
+// SetGroupExternalID сохраняет externalId группы.
+func (r *Repository) SetGroupExternalID(ctx context.Context, tx pgx.Tx, teamName, externalID string) error {
+	const op = "scim.repository.SetGroupExternalID"
+
+	if _, err := tx.Exec(ctx, setGroupExternalIDQuery, teamName, externalID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
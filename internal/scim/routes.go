@@ -0,0 +1,285 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+type Handler struct {
+	service *Service
+	token   string
+	logger  *slog.Logger
+}
+
+func NewHandler(service *Service, token string, logger *slog.Logger) *Handler {
+	return &Handler{
+		service: service,
+		token:   token,
+		logger:  logger,
+	}
+}
+
+func (h *Handler) log(r *http.Request) *slog.Logger {
+	return logging.FromContext(r.Context(), h.logger)
+}
+
+// Routes возвращает роутер SCIM 2.0, который монтируется в /scim/v2.
+// Все запросы требуют заголовок Authorization: Bearer <SCIM_TOKEN>.
+func (h *Handler) Routes() http.Handler {
+	router := chi.NewRouter()
+	router.Use(h.authenticate)
+
+	router.Get("/Users", h.listUsers)
+	router.Post("/Users", h.createUser)
+	router.Get("/Users/{id}", h.getUser)
+	router.Put("/Users/{id}", h.replaceUser)
+	router.Patch("/Users/{id}", h.patchUser)
+	router.Delete("/Users/{id}", h.deleteUser)
+
+	router.Get("/Groups", h.listGroups)
+	router.Post("/Groups", h.createGroup)
+	router.Get("/Groups/{id}", h.getGroup)
+	router.Put("/Groups/{id}", h.replaceGroup)
+	router.Patch("/Groups/{id}", h.patchGroup)
+	router.Delete("/Groups/{id}", h.deleteGroup)
+
+	return router
+}
+
+// authenticate проверяет bearer-токен провайдера учетных записей.
+func (h *Handler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			h.writeError(w, http.StatusUnauthorized, "", "invalid or missing bearer token")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePage(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	resp, err := h.service.ListUsers(r.Context(), r.URL.Query().Get("filter"), page)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, resp)
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.service.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, user)
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) {
+	var body User
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	user, err := h.service.CreateUser(r.Context(), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusCreated, user)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request) {
+	var body User
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	user, err := h.service.ReplaceUser(r.Context(), chi.URLParam(r, "id"), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, user)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request) {
+	var body PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	user, err := h.service.PatchUser(r.Context(), chi.URLParam(r, "id"), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, user)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteUser(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePage(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	resp, err := h.service.ListGroups(r.Context(), r.URL.Query().Get("filter"), page)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, resp)
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := h.service.GetGroup(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, group)
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) {
+	var body Group
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	group, err := h.service.CreateGroup(r.Context(), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusCreated, group)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request) {
+	var body Group
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	group, err := h.service.ReplaceGroup(r.Context(), chi.URLParam(r, "id"), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, group)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request) {
+	var body PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	group, err := h.service.PatchGroup(r.Context(), chi.URLParam(r, "id"), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, http.StatusOK, group)
+}
+
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteGroup(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleError отображает ошибки сервиса на ответы об ошибках SCIM (RFC 7644 §3.12).
+func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, errInvalidFilter):
+		h.writeError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+	case errors.Is(err, types.ErrInvalidInput):
+		h.writeError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	case errors.Is(err, types.ErrNotFound):
+		h.writeError(w, http.StatusNotFound, "", "resource not found")
+	case errors.Is(err, types.ErrAlreadyExists):
+		h.writeError(w, http.StatusConflict, "uniqueness", "resource already exists")
+	case errors.Is(err, types.ErrTeamHasOpenReviews):
+		h.writeError(w, http.StatusConflict, "mutability", "team pull requests still have open reviews")
+	default:
+		h.log(r).ErrorContext(r.Context(), "Internal Server Error", "error", err, "path", r.URL.Path)
+		h.writeError(w, http.StatusInternalServerError, "", "internal server error")
+	}
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, status int, scimType, detail string) {
+	h.writeJson(w, status, ErrorResponse{
+		Schemas:  []string{schemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func (h *Handler) writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.logger.Error("failed to write scim response", "error", err)
+	}
+}
+
+// parsePage разбирает startIndex и count (RFC 7644 §3.4.2.4).
+func parsePage(r *http.Request) (Page, error) {
+	page := Page{StartIndex: 1, Count: defaultPageSize}
+
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		startIndex, err := strconv.Atoi(v)
+		if err != nil {
+			return Page{}, fmt.Errorf("invalid startIndex: %w", err)
+		}
+		page.StartIndex = max(startIndex, 1)
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return Page{}, fmt.Errorf("invalid count: %w", err)
+		}
+		page.Count = min(max(count, 0), maxPageSize)
+	}
+	return page, nil
+}
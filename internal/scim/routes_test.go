@@ -0,0 +1,53 @@
+package scim_test
+
+import (
+	"deplagene/avito-tech-internship/internal/scim"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestAuthenticate проверяет bearer-токен. Запрос с верным токеном доходит до обработчика
+// и отклоняется им из-за некорректного startIndex еще до обращения к базе.
+func TestAuthenticate(t *testing.T) {
+	handler := scim.NewHandler(nil, "scim-token", slog.New(slog.NewTextHandler(io.Discard, nil))).Routes()
+
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+	}{
+		{name: "valid token", header: "Bearer scim-token", wantStatus: http.StatusBadRequest},
+		{name: "missing header", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", header: "Bearer other-token", wantStatus: http.StatusUnauthorized},
+		{name: "token prefix", header: "Bearer scim", wantStatus: http.StatusUnauthorized},
+		{name: "not a bearer scheme", header: "Basic scim-token", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/Users?startIndex=first", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/scim+json" {
+				t.Errorf("content type %q, want application/scim+json", got)
+			}
+			var body scim.ErrorResponse
+			if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+				t.Fatalf("decode error body: %v", err)
+			}
+			if len(body.Schemas) != 1 || body.Schemas[0] != "urn:ietf:params:scim:api:messages:2.0:Error" {
+				t.Errorf("schemas = %v, want SCIM error schema", body.Schemas)
+			}
+		})
+	}
+}
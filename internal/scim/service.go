@@ -0,0 +1,971 @@
+package scim
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var errInvalidFilter = errors.New("invalid filter")
+
+// Service реализует подмножество SCIM 2.0 поверх пользователей и команд:
+// Users отображаются на таблицу users, Groups — на teams и team_memberships.
+type Service struct {
+	scimRepo   *Repository
+	userRepo   types.UserRepository
+	teamRepo   types.TeamRepository
+	reassigner types.ReviewReassigner
+	events     types.EventRecorder
+	db         *pgxpool.Pool
+	logger     *slog.Logger
+}
+
+func NewService(
+	scimRepo *Repository,
+	userRepo types.UserRepository,
+	teamRepo types.TeamRepository,
+	reassigner types.ReviewReassigner,
+	events types.EventRecorder,
+	db *pgxpool.Pool,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		scimRepo:   scimRepo,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		reassigner: reassigner,
+		events:     events,
+		db:         db,
+		logger:     logger,
+	}
+}
+
+// log нужен, чтобы записи провижининга несли request_id запроса от провайдера.
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// ListUsers ищет пользователей по фильтру (id, userName, externalId, active).
+func (s *Service) ListUsers(ctx context.Context, rawFilter string, page Page) (resp *ListResponse[User], err error) {
+	const op = "scim.service.ListUsers"
+
+	filter, err := ParseFilter(rawFilter)
+	if err == nil {
+		err = filter.Only("id", "username", "externalid", "active")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, errInvalidFilter, err)
+	}
+	active, err := filter.Bool("active")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, errInvalidFilter, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	records, total, err := s.scimRepo.ListUsers(ctx, tx, userFilter{
+		UserID:     filter.String("id"),
+		Username:   filter.String("username"),
+		ExternalID: filter.String("externalid"),
+		Active:     active,
+	}, page)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp = &ListResponse[User]{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   page.StartIndex,
+		Resources:    make([]User, 0, len(records)),
+	}
+	for _, record := range records {
+		user, err := s.toUser(ctx, tx, record)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		resp.Resources = append(resp.Resources, *user)
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp, nil
+}
+
+// GetUser возвращает пользователя по id.
+func (s *Service) GetUser(ctx context.Context, id string) (user *User, err error) {
+	const op = "scim.service.GetUser"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	record, err := s.scimRepo.GetUser(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return nil, types.ErrNotFound
+	}
+
+	user, err = s.toUser(ctx, tx, *record)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return user, nil
+}
+
+// CreateUser создает пользователя. id генерируется сервером, в команды пользователь
+// попадает через Groups.
+func (s *Service) CreateUser(ctx context.Context, in User) (user *User, err error) {
+	const op = "scim.service.CreateUser"
+
+	if strings.TrimSpace(in.UserName) == "" {
+		return nil, fmt.Errorf("%s: userName is required: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	if err = s.ensureUniqueUserName(ctx, tx, in.UserName, ""); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	record := userRecord{
+		UserID:     uuid.NewString(),
+		Username:   in.UserName,
+		ExternalID: in.ExternalID,
+		Role:       in.Title,
+		IsActive:   in.Active == nil || *in.Active,
+	}
+	if err = s.scimRepo.CreateUser(ctx, tx, record); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventUSERCREATED, UserId: record.UserID}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := s.scimRepo.GetUser(ctx, tx, record.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err = s.toUser(ctx, tx, *created)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return user, nil
+}
+
+// ReplaceUser полностью заменяет атрибуты пользователя (PUT).
+// Деактивация пользователя снимает его со всех открытых ревью.
+func (s *Service) ReplaceUser(ctx context.Context, id string, in User) (*User, error) {
+	return s.updateUser(ctx, "scim.service.ReplaceUser", id, func(current User) (User, error) {
+		if strings.TrimSpace(in.UserName) == "" {
+			return User{}, fmt.Errorf("userName is required: %w", types.ErrInvalidInput)
+		}
+		if in.Active == nil {
+			in.Active = api.Ptr(true)
+		}
+		return in, nil
+	})
+}
+
+// PatchUser применяет операции PATCH к атрибутам пользователя.
+// Деактивация пользователя снимает его со всех открытых ревью.
+func (s *Service) PatchUser(ctx context.Context, id string, patch PatchRequest) (*User, error) {
+	return s.updateUser(ctx, "scim.service.PatchUser", id, func(current User) (User, error) {
+		for _, operation := range patch.Operations {
+			if err := applyUserOperation(&current, operation); err != nil {
+				return User{}, err
+			}
+		}
+		return current, nil
+	})
+}
+
+// DeleteUser отзывает доступ пользователя: он деактивируется, исключается из всех команд,
+// а его открытые ревью переназначаются. Запись сохраняется, так как на нее ссылаются PR,
+// но помечается удаленной: для SCIM пользователя больше нет, и повторные запросы получают 404.
+func (s *Service) DeleteUser(ctx context.Context, id string) (err error) {
+	const op = "scim.service.DeleteUser"
+
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	record, err := s.scimRepo.GetUser(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return types.ErrNotFound
+	}
+
+	if err = s.deprovision(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	teams, err := s.userRepo.GetTeamsByUserID(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, teamName := range teams {
+		if _, err = s.userRepo.RemoveFromTeam(ctx, tx, id, teamName); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err = s.recordMembership(ctx, tx, api.DomainEventTEAMMEMBERREMOVED, teamName, id); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = s.scimRepo.MarkUserDeleted(ctx, tx, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListGroups ищет группы по фильтру (id, displayName, externalId).
+func (s *Service) ListGroups(ctx context.Context, rawFilter string, page Page) (resp *ListResponse[Group], err error) {
+	const op = "scim.service.ListGroups"
+
+	filter, err := ParseFilter(rawFilter)
+	if err == nil {
+		err = filter.Only("id", "displayname", "externalid")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w: %w", op, errInvalidFilter, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	records, total, err := s.scimRepo.ListGroups(ctx, tx, groupFilter{
+		ScimID:     filter.String("id"),
+		TeamName:   filter.String("displayname"),
+		ExternalID: filter.String("externalid"),
+	}, page)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp = &ListResponse[Group]{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   page.StartIndex,
+		Resources:    make([]Group, 0, len(records)),
+	}
+	for _, record := range records {
+		group, err := s.toGroup(ctx, tx, record)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		resp.Resources = append(resp.Resources, *group)
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp, nil
+}
+
+// GetGroup возвращает группу по id.
+func (s *Service) GetGroup(ctx context.Context, id string) (group *Group, err error) {
+	const op = "scim.service.GetGroup"
+
+	if err := uuid.Validate(id); err != nil {
+		return nil, types.ErrNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	record, err := s.scimRepo.GetGroup(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return nil, types.ErrNotFound
+	}
+
+	group, err = s.toGroup(ctx, tx, *record)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return group, nil
+}
+
+// CreateGroup создает команду и добавляет в нее перечисленных пользователей.
+func (s *Service) CreateGroup(ctx context.Context, in Group) (group *Group, err error) {
+	const op = "scim.service.CreateGroup"
+
+	if strings.TrimSpace(in.DisplayName) == "" {
+		return nil, fmt.Errorf("%s: displayName is required: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, in.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if exists {
+		return nil, types.ErrAlreadyExists
+	}
+
+	if err = s.teamRepo.Create(ctx, tx, api.Team{TeamName: in.DisplayName}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMCREATED, TeamName: in.DisplayName}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	record, err := s.scimRepo.GetGroupByName(ctx, tx, in.DisplayName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.applyGroup(ctx, tx, *record, in); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	group, err = s.loadGroup(ctx, tx, record.ScimID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return group, nil
+}
+
+// ReplaceGroup полностью заменяет группу (PUT): имя, externalId и состав.
+func (s *Service) ReplaceGroup(ctx context.Context, id string, in Group) (*Group, error) {
+	return s.updateGroup(ctx, "scim.service.ReplaceGroup", id, func(current Group) (Group, error) {
+		if strings.TrimSpace(in.DisplayName) == "" {
+			return Group{}, fmt.Errorf("displayName is required: %w", types.ErrInvalidInput)
+		}
+		return in, nil
+	})
+}
+
+// PatchGroup применяет операции PATCH к группе (displayName, externalId, members).
+func (s *Service) PatchGroup(ctx context.Context, id string, patch PatchRequest) (*Group, error) {
+	return s.updateGroup(ctx, "scim.service.PatchGroup", id, func(current Group) (Group, error) {
+		for _, operation := range patch.Operations {
+			if err := applyGroupOperation(&current, operation); err != nil {
+				return Group{}, err
+			}
+		}
+		return current, nil
+	})
+}
+
+// DeleteGroup удаляет команду. Как и /team/delete, отказывает, пока у участников есть открытые ревью.
+func (s *Service) DeleteGroup(ctx context.Context, id string) (err error) {
+	const op = "scim.service.DeleteGroup"
+
+	if err := uuid.Validate(id); err != nil {
+		return types.ErrNotFound
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	record, err := s.scimRepo.GetGroup(ctx, tx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return types.ErrNotFound
+	}
+
+	openReviews, err := s.teamRepo.CountOpenReviews(ctx, tx, record.TeamName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if openReviews > 0 {
+		return types.ErrTeamHasOpenReviews
+	}
+
+	children, err := s.teamRepo.Delete(ctx, tx, record.TeamName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMDELETED, TeamName: record.TeamName}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, child := range children {
+		if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMUPDATED, TeamName: child}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// updateUser — общая часть PUT и PATCH для пользователей: читает текущее состояние,
+// вычисляет новое через mutate и сохраняет его в одной транзакции.
+func (s *Service) updateUser(ctx context.Context, op, id string, mutate func(User) (User, error)) (user *User, err error) {
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	record, err := s.scimRepo.GetUser(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return nil, types.ErrNotFound
+	}
+
+	current, err := s.toUser(ctx, tx, *record)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	desired, err := mutate(*current)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !strings.EqualFold(desired.UserName, record.Username) {
+		if err = s.ensureUniqueUserName(ctx, tx, desired.UserName, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	updated := userRecord{
+		UserID:     id,
+		Username:   desired.UserName,
+		ExternalID: desired.ExternalID,
+		Role:       desired.Title,
+		IsActive:   desired.Active == nil || *desired.Active,
+	}
+	if err = s.scimRepo.UpdateUser(ctx, tx, updated); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case record.IsActive && !updated.IsActive:
+		if err = s.deprovision(ctx, tx, id); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	case !record.IsActive && updated.IsActive:
+		if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventUSERACTIVATED, UserId: id}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	user, err = s.toUser(ctx, tx, updated)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return user, nil
+}
+
+// updateGroup — общая часть PUT и PATCH для групп.
+func (s *Service) updateGroup(ctx context.Context, op, id string, mutate func(Group) (Group, error)) (group *Group, err error) {
+	if err := uuid.Validate(id); err != nil {
+		return nil, types.ErrNotFound
+	}
+
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	record, err := s.scimRepo.GetGroup(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if record == nil {
+		return nil, types.ErrNotFound
+	}
+
+	current, err := s.toGroup(ctx, tx, *record)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	desired, err := mutate(*current)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if desired.DisplayName != record.TeamName {
+		exists, err := s.teamRepo.Exists(ctx, tx, desired.DisplayName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if exists {
+			return nil, types.ErrAlreadyExists
+		}
+		if err := s.teamRepo.Rename(ctx, tx, record.TeamName, desired.DisplayName); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		event := api.DomainEvent{
+			Type:             api.DomainEventTEAMRENAMED,
+			TeamName:         desired.DisplayName,
+			PreviousTeamName: record.TeamName,
+		}
+		if err := s.events.Record(ctx, tx, event); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		record.TeamName = desired.DisplayName
+	}
+
+	if err = s.applyGroup(ctx, tx, *record, desired); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	group, err = s.loadGroup(ctx, tx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return group, nil
+}
+
+// applyGroup приводит externalId и состав команды к desired. Исключенные участники
+// снимаются с открытых ревью этой команды.
+func (s *Service) applyGroup(ctx context.Context, tx pgx.Tx, record groupRecord, desired Group) error {
+	if err := s.scimRepo.SetGroupExternalID(ctx, tx, record.TeamName, desired.ExternalID); err != nil {
+		return err
+	}
+
+	current, err := s.scimRepo.GetGroupMembers(ctx, tx, record.TeamName)
+	if err != nil {
+		return err
+	}
+
+	var currentIDs, desiredIDs []string
+	for _, m := range current {
+		currentIDs = append(currentIDs, m.Value)
+	}
+	for _, m := range desired.Members {
+		desiredIDs = append(desiredIDs, m.Value)
+	}
+
+	for _, userID := range desiredIDs {
+		if slices.Contains(currentIDs, userID) {
+			continue
+		}
+		// Удаленные через SCIM пользователи в группы не возвращаются
+		user, err := s.scimRepo.GetUser(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("member %s does not exist: %w", userID, types.ErrInvalidInput)
+		}
+		if err := s.userRepo.AddMembership(ctx, tx, userID, record.TeamName); err != nil {
+			return err
+		}
+		if err := s.recordMembership(ctx, tx, api.DomainEventTEAMMEMBERADDED, record.TeamName, userID); err != nil {
+			return err
+		}
+	}
+
+	for _, userID := range currentIDs {
+		if slices.Contains(desiredIDs, userID) {
+			continue
+		}
+		if _, err := s.userRepo.RemoveFromTeam(ctx, tx, userID, record.TeamName); err != nil {
+			return err
+		}
+		if err := s.recordMembership(ctx, tx, api.DomainEventTEAMMEMBERREMOVED, record.TeamName, userID); err != nil {
+			return err
+		}
+		if err := s.reassigner.ReassignOpenReviews(ctx, tx, userID, record.TeamName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deprovision деактивирует пользователя и переназначает все его открытые ревью.
+func (s *Service) deprovision(ctx context.Context, tx pgx.Tx, userID string) error {
+	if err := s.userRepo.SetIsActive(ctx, tx, userID, false); err != nil {
+		return err
+	}
+	if err := s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventUSERDEACTIVATED, UserId: userID}); err != nil {
+		return err
+	}
+	if err := s.reassigner.ReassignOpenReviews(ctx, tx, userID, ""); err != nil {
+		return err
+	}
+	s.log(ctx).InfoContext(ctx, "scim user deprovisioned", "user_id", userID)
+	return nil
+}
+
+// recordMembership публикует вступление пользователя в команду или выход из нее.
+func (s *Service) recordMembership(ctx context.Context, tx pgx.Tx, eventType api.DomainEventType, teamName, userID string) error {
+	return s.events.Record(ctx, tx, api.DomainEvent{Type: eventType, TeamName: teamName, UserId: userID})
+}
+
+// ensureUniqueUserName проверяет, что userName не занят другим пользователем.
+func (s *Service) ensureUniqueUserName(ctx context.Context, tx pgx.Tx, userName, selfID string) error {
+	existing, _, err := s.scimRepo.ListUsers(ctx, tx, userFilter{Username: &userName}, Page{StartIndex: 1, Count: 2})
+	if err != nil {
+		return err
+	}
+	for _, u := range existing {
+		if u.UserID != selfID {
+			return types.ErrAlreadyExists
+		}
+	}
+	return nil
+}
+
+func (s *Service) loadGroup(ctx context.Context, tx pgx.Tx, id string) (*Group, error) {
+	record, err := s.scimRepo.GetGroup(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, types.ErrNotFound
+	}
+	return s.toGroup(ctx, tx, *record)
+}
+
+func (s *Service) toUser(ctx context.Context, tx pgx.Tx, record userRecord) (*User, error) {
+	groups, err := s.scimRepo.GetUserGroups(ctx, tx, record.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &User{
+		Schemas:     []string{schemaUser},
+		ID:          record.UserID,
+		ExternalID:  record.ExternalID,
+		UserName:    record.Username,
+		DisplayName: record.Username,
+		Title:       record.Role,
+		Active:      api.Ptr(record.IsActive),
+		Groups:      groups,
+		Meta:        &Meta{ResourceType: "User", Location: "/scim/v2/Users/" + record.UserID},
+	}, nil
+}
+
+func (s *Service) toGroup(ctx context.Context, tx pgx.Tx, record groupRecord) (*Group, error) {
+	members, err := s.scimRepo.GetGroupMembers(ctx, tx, record.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	return &Group{
+		Schemas:     []string{schemaGroup},
+		ID:          record.ScimID,
+		ExternalID:  record.ExternalID,
+		DisplayName: record.TeamName,
+		Members:     members,
+		Meta:        &Meta{ResourceType: "Group", Location: "/scim/v2/Groups/" + record.ScimID},
+	}, nil
+}
+
+// applyUserOperation применяет одну операцию PATCH к пользователю.
+// Поддерживаются add/replace для userName, displayName, externalId, title и active.
+func applyUserOperation(user *User, operation PatchOperation) error {
+	switch strings.ToLower(operation.Op) {
+	case "add", "replace":
+	default:
+		return fmt.Errorf("unsupported user patch op %q: %w", operation.Op, types.ErrInvalidInput)
+	}
+
+	values := map[string]json.RawMessage{}
+	if operation.Path == "" {
+		if err := json.Unmarshal(operation.Value, &values); err != nil {
+			return fmt.Errorf("patch value must be an object: %w", types.ErrInvalidInput)
+		}
+	} else {
+		values[operation.Path] = operation.Value
+	}
+
+	for path, raw := range values {
+		var target any
+		switch strings.ToLower(path) {
+		case "username":
+			target = &user.UserName
+		case "displayname":
+			// displayName и userName хранятся в одном поле username
+			target = &user.UserName
+		case "externalid":
+			target = &user.ExternalID
+		case "title":
+			target = &user.Title
+		case "active":
+			target = &user.Active
+		case "schemas", "id", "meta", "groups", "name", "emails":
+			continue
+		default:
+			return fmt.Errorf("unsupported user attribute %q: %w", path, types.ErrInvalidInput)
+		}
+		if err := json.Unmarshal(raw, target); err != nil {
+			if strings.EqualFold(path, "active") {
+				// Некоторые IdP (например, Azure AD) присылают "True"/"False" строкой
+				var str string
+				if json.Unmarshal(raw, &str) == nil {
+					active := strings.EqualFold(str, "true")
+					user.Active = &active
+					continue
+				}
+			}
+			return fmt.Errorf("invalid value for %s: %w", path, types.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// applyGroupOperation применяет одну операцию PATCH к группе.
+func applyGroupOperation(group *Group, operation PatchOperation) error {
+	op := strings.ToLower(operation.Op)
+	path := strings.TrimSpace(operation.Path)
+
+	if path == "" {
+		if op != "add" && op != "replace" {
+			return fmt.Errorf("op %q requires a path: %w", operation.Op, types.ErrInvalidInput)
+		}
+		var value Group
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return fmt.Errorf("patch value must be an object: %w", types.ErrInvalidInput)
+		}
+		if value.DisplayName != "" {
+			group.DisplayName = value.DisplayName
+		}
+		if value.ExternalID != "" {
+			group.ExternalID = value.ExternalID
+		}
+		if value.Members != nil {
+			if op == "replace" {
+				group.Members = value.Members
+			} else {
+				group.Members = mergeMembers(group.Members, value.Members)
+			}
+		}
+		return nil
+	}
+
+	lowerPath := strings.ToLower(path)
+	switch {
+	case lowerPath == "displayname" && op != "remove":
+		if err := json.Unmarshal(operation.Value, &group.DisplayName); err != nil {
+			return fmt.Errorf("invalid displayName: %w", types.ErrInvalidInput)
+		}
+	case lowerPath == "externalid":
+		group.ExternalID = ""
+		if op != "remove" {
+			if err := json.Unmarshal(operation.Value, &group.ExternalID); err != nil {
+				return fmt.Errorf("invalid externalId: %w", types.ErrInvalidInput)
+			}
+		}
+	case lowerPath == "members":
+		var members []MemberRef
+		if len(operation.Value) > 0 {
+			if err := json.Unmarshal(operation.Value, &members); err != nil {
+				return fmt.Errorf("invalid members: %w", types.ErrInvalidInput)
+			}
+		}
+		switch op {
+		case "add":
+			group.Members = mergeMembers(group.Members, members)
+		case "replace":
+			group.Members = members
+		case "remove":
+			if members == nil {
+				group.Members = nil
+			} else {
+				group.Members = removeMembers(group.Members, members)
+			}
+		default:
+			return fmt.Errorf("unsupported group patch op %q: %w", operation.Op, types.ErrInvalidInput)
+		}
+	case strings.HasPrefix(lowerPath, "members[") && op == "remove":
+		// members[value eq "user-id"]
+		filter, err := ParseFilter(strings.TrimSuffix(path[len("members["):], "]"))
+		if err != nil || filter.String("value") == nil {
+			return fmt.Errorf("unsupported members filter %q: %w", path, types.ErrInvalidInput)
+		}
+		group.Members = removeMembers(group.Members, []MemberRef{{Value: *filter.String("value")}})
+	default:
+		return fmt.Errorf("unsupported group patch %s %q: %w", operation.Op, path, types.ErrInvalidInput)
+	}
+	return nil
+}
+
+func mergeMembers(current, added []MemberRef) []MemberRef {
+	for _, m := range added {
+		if !slices.ContainsFunc(current, func(c MemberRef) bool { return c.Value == m.Value }) {
+			current = append(current, m)
+		}
+	}
+	return current
+}
+
+func removeMembers(current, removed []MemberRef) []MemberRef {
+	return slices.DeleteFunc(current, func(c MemberRef) bool {
+		return slices.ContainsFunc(removed, func(r MemberRef) bool { return r.Value == c.Value })
+	})
+}
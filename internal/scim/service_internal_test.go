@@ -0,0 +1,192 @@
+package scim
+
+import (
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestApplyUserOperation(t *testing.T) {
+	base := func() User {
+		return User{UserName: "alice", ExternalID: "00u1", Title: "member", Active: api.Ptr(true)}
+	}
+
+	tests := []struct {
+		name      string
+		operation PatchOperation
+		want      func(*User)
+		wantErr   bool
+	}{
+		{
+			name:      "okta replace without path",
+			operation: PatchOperation{Op: "replace", Value: []byte(`{"active":false}`)},
+			want:      func(u *User) { u.Active = api.Ptr(false) },
+		},
+		{
+			name:      "add without path",
+			operation: PatchOperation{Op: "add", Value: []byte(`{"userName":"bob","title":"lead","emails":[{"value":"bob@example.com"}]}`)},
+			want:      func(u *User) { u.UserName = "bob"; u.Title = "lead" },
+		},
+		{
+			name:      "entra replace active as string",
+			operation: PatchOperation{Op: "Replace", Path: "active", Value: []byte(`"False"`)},
+			want:      func(u *User) { u.Active = api.Ptr(false) },
+		},
+		{
+			name:      "entra add displayName with path",
+			operation: PatchOperation{Op: "Add", Path: "displayName", Value: []byte(`"Alice Smith"`)},
+			want:      func(u *User) { u.UserName = "Alice Smith" },
+		},
+		{
+			name:      "replace externalId with path",
+			operation: PatchOperation{Op: "replace", Path: "externalId", Value: []byte(`"00u2"`)},
+			want:      func(u *User) { u.ExternalID = "00u2" },
+		},
+		{
+			name:      "remove is not supported",
+			operation: PatchOperation{Op: "remove", Path: "title"},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown attribute",
+			operation: PatchOperation{Op: "replace", Path: "nickName", Value: []byte(`"al"`)},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid value",
+			operation: PatchOperation{Op: "replace", Path: "active", Value: []byte(`5`)},
+			wantErr:   true,
+		},
+		{
+			name:      "value without path is not an object",
+			operation: PatchOperation{Op: "replace", Value: []byte(`"bob"`)},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base()
+			err := applyUserOperation(&got, tt.operation)
+			if tt.wantErr {
+				if !errors.Is(err, types.ErrInvalidInput) {
+					t.Fatalf("err = %v, want ErrInvalidInput", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("apply: %v", err)
+			}
+			want := base()
+			tt.want(&want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("user = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyGroupOperation(t *testing.T) {
+	base := func() Group {
+		return Group{DisplayName: "backend", ExternalID: "00g1", Members: []MemberRef{{Value: "u1"}, {Value: "u2"}}}
+	}
+
+	tests := []struct {
+		name      string
+		operation PatchOperation
+		want      func(*Group)
+		wantErr   bool
+	}{
+		{
+			name:      "okta replace without path",
+			operation: PatchOperation{Op: "replace", Value: []byte(`{"id":"abc","displayName":"platform"}`)},
+			want:      func(g *Group) { g.DisplayName = "platform" },
+		},
+		{
+			name:      "okta add members with path",
+			operation: PatchOperation{Op: "add", Path: "members", Value: []byte(`[{"value":"u2"},{"value":"u3","display":"carol"}]`)},
+			want:      func(g *Group) { g.Members = append(g.Members, MemberRef{Value: "u3", Display: "carol"}) },
+		},
+		{
+			name:      "okta remove members with path",
+			operation: PatchOperation{Op: "remove", Path: "members", Value: []byte(`[{"value":"u1"}]`)},
+			want:      func(g *Group) { g.Members = []MemberRef{{Value: "u2"}} },
+		},
+		{
+			name:      "entra remove member by filter",
+			operation: PatchOperation{Op: "Remove", Path: `members[value eq "u2"]`},
+			want:      func(g *Group) { g.Members = []MemberRef{{Value: "u1"}} },
+		},
+		{
+			name:      "entra add members without path",
+			operation: PatchOperation{Op: "Add", Value: []byte(`{"members":[{"value":"u3"}]}`)},
+			want:      func(g *Group) { g.Members = append(g.Members, MemberRef{Value: "u3"}) },
+		},
+		{
+			name:      "replace members without path",
+			operation: PatchOperation{Op: "replace", Value: []byte(`{"members":[{"value":"u3"}]}`)},
+			want:      func(g *Group) { g.Members = []MemberRef{{Value: "u3"}} },
+		},
+		{
+			name:      "replace members with path",
+			operation: PatchOperation{Op: "replace", Path: "members", Value: []byte(`[{"value":"u4"}]`)},
+			want:      func(g *Group) { g.Members = []MemberRef{{Value: "u4"}} },
+		},
+		{
+			name:      "remove all members",
+			operation: PatchOperation{Op: "remove", Path: "members"},
+			want:      func(g *Group) { g.Members = nil },
+		},
+		{
+			name:      "replace displayName with path",
+			operation: PatchOperation{Op: "replace", Path: "displayName", Value: []byte(`"platform"`)},
+			want:      func(g *Group) { g.DisplayName = "platform" },
+		},
+		{
+			name:      "remove externalId",
+			operation: PatchOperation{Op: "remove", Path: "externalId"},
+			want:      func(g *Group) { g.ExternalID = "" },
+		},
+		{
+			name:      "remove without path",
+			operation: PatchOperation{Op: "remove"},
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported path",
+			operation: PatchOperation{Op: "replace", Path: "owner", Value: []byte(`"u1"`)},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid members value",
+			operation: PatchOperation{Op: "add", Path: "members", Value: []byte(`{"value":"u3"}`)},
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported member filter",
+			operation: PatchOperation{Op: "remove", Path: `members[display co "a"]`},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := base()
+			err := applyGroupOperation(&got, tt.operation)
+			if tt.wantErr {
+				if !errors.Is(err, types.ErrInvalidInput) {
+					t.Fatalf("err = %v, want ErrInvalidInput", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("apply: %v", err)
+			}
+			want := base()
+			tt.want(&want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("group = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package stats
+
+import (
+	"bytes"
+	"deplagene/avito-tech-internship/cmd/api"
+	"encoding/csv"
+	"strconv"
+	"time"
+)
+
+var teamStatsColumns = []string{
+	"team_name",
+	"week_start",
+	"week_prs_created",
+	"week_prs_merged",
+	"prs_created",
+	"prs_merged",
+	"median_hours_to_merge",
+	"p90_hours_to_merge",
+	"share_under_two_reviewers",
+	"no_candidate_events",
+}
+
+// writeTeamStatsCSV сериализует метрики команд в CSV. nil-метрики пишутся пустыми ячейками.
+func writeTeamStatsCSV(teams []api.TeamStats) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(teamStatsColumns); err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		summary := []string{
+			strconv.Itoa(team.PrsCreated),
+			strconv.Itoa(team.PrsMerged),
+			formatFloat(team.MedianHoursToMerge),
+			formatFloat(team.P90HoursToMerge),
+			formatFloat(team.ShareUnderTwoReviewers),
+			strconv.Itoa(team.NoCandidateEvents),
+		}
+
+		weeks := team.Weeks
+		if len(weeks) == 0 {
+			record := append([]string{team.TeamName, "", "0", "0"}, summary...)
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for _, week := range weeks {
+			record := append([]string{
+				team.TeamName,
+				week.WeekStart.Format(time.DateOnly),
+				strconv.Itoa(week.PrsCreated),
+				strconv.Itoa(week.PrsMerged),
+			}, summary...)
+			if err := writer.Write(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', 2, 64)
+}
@@ -0,0 +1,199 @@
+package stats
+
+var (
+	// Все фильтры необязательны: NULL в параметре отключает соответствующее условие.
+	// $1 — команда, [$2, $3) — окно по времени событий и merged_at.
+	// Открытые ревью считаются на текущий момент и окном не ограничиваются.
+	getReviewerStatsQuery = `
+		WITH scoped_users AS (
+			SELECT u.user_id, u.username, COALESCE(u.team_name, '') AS team_name, u.is_active
+			FROM users u
+			WHERE $1::text IS NULL OR EXISTS (
+				SELECT 1 FROM team_memberships m WHERE m.user_id = u.user_id AND m.team_name = $1
+			)
+		),
+		events AS (
+			SELECT e.user_id,
+			       COUNT(*) FILTER (WHERE e.event_type = 'ASSIGNED') AS assigned,
+			       COUNT(*) FILTER (WHERE e.event_type = 'UNASSIGNED') AS unassigned
+			FROM review_events e
+			WHERE ($1::text IS NULL OR e.team_name = $1)
+				AND ($2::timestamptz IS NULL OR e.created_at >= $2)
+				AND ($3::timestamptz IS NULL OR e.created_at < $3)
+			GROUP BY e.user_id
+		),
+		open_reviews AS (
+			SELECT rev.user_id, COUNT(*) AS open_reviews
+			FROM reviewers rev
+			JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+			WHERE pr.status = 'OPEN' AND ($1::text IS NULL OR pr.team_name = $1)
+			GROUP BY rev.user_id
+		),
+		merged AS (
+			SELECT rev.user_id,
+			       COUNT(*) AS merged_reviews,
+			       AVG(EXTRACT(EPOCH FROM pr.merged_at - rev.assigned_at)) / 3600 AS avg_hours_to_merge
+			FROM reviewers rev
+			JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+			WHERE pr.status = 'MERGED'
+				AND ($1::text IS NULL OR pr.team_name = $1)
+				AND ($2::timestamptz IS NULL OR pr.merged_at >= $2)
+				AND ($3::timestamptz IS NULL OR pr.merged_at < $3)
+			GROUP BY rev.user_id
+		)
+		SELECT su.user_id, su.username, su.team_name, su.is_active,
+		       COALESCE(o.open_reviews, 0), COALESCE(e.assigned, 0), COALESCE(e.unassigned, 0),
+		       COALESCE(m.merged_reviews, 0), m.avg_hours_to_merge::float8
+		FROM scoped_users su
+		LEFT JOIN events e ON e.user_id = su.user_id
+		LEFT JOIN open_reviews o ON o.user_id = su.user_id
+		LEFT JOIN merged m ON m.user_id = su.user_id
+		ORDER BY su.user_id;
+	`
+
+	// Процентили времени до merge — по методу ближайшего ранга: минимальное значение,
+	// у которого CUME_DIST в своей команде не меньше нужного уровня.
+	getTeamStatsQuery = `
+		WITH prs AS (
+			SELECT pr.team_name, pr.created_at, pr.merged_at, pr.initial_reviewer_count
+			FROM pull_requests pr
+			WHERE pr.team_name IS NOT NULL AND ($1::text IS NULL OR pr.team_name = $1)
+		),
+		created AS (
+			SELECT team_name,
+			       COUNT(*) AS prs_created,
+			       AVG((initial_reviewer_count < 2)::int)::float8 AS share_under_two
+			FROM prs
+			WHERE ($2::timestamptz IS NULL OR created_at >= $2)
+				AND ($3::timestamptz IS NULL OR created_at < $3)
+			GROUP BY team_name
+		),
+		durations AS (
+			SELECT team_name,
+			       EXTRACT(EPOCH FROM merged_at - created_at)::float8 / 3600 AS hours,
+			       CUME_DIST() OVER (PARTITION BY team_name ORDER BY merged_at - created_at) AS pct
+			FROM prs
+			WHERE merged_at IS NOT NULL
+				AND ($2::timestamptz IS NULL OR merged_at >= $2)
+				AND ($3::timestamptz IS NULL OR merged_at < $3)
+		),
+		merged AS (
+			SELECT team_name,
+			       COUNT(*) AS prs_merged,
+			       MIN(hours) FILTER (WHERE pct >= 0.5) AS median_hours,
+			       MIN(hours) FILTER (WHERE pct >= 0.9) AS p90_hours
+			FROM durations
+			GROUP BY team_name
+		),
+		no_candidate AS (
+			SELECT team_name, COUNT(*) AS events
+			FROM review_events
+			WHERE event_type = 'NO_CANDIDATE'
+				AND ($1::text IS NULL OR team_name = $1)
+				AND ($2::timestamptz IS NULL OR created_at >= $2)
+				AND ($3::timestamptz IS NULL OR created_at < $3)
+			GROUP BY team_name
+		)
+		SELECT t.team_name,
+		       COALESCE(c.prs_created, 0), COALESCE(m.prs_merged, 0),
+		       m.median_hours, m.p90_hours, c.share_under_two,
+		       COALESCE(n.events, 0)
+		FROM teams t
+		LEFT JOIN created c ON c.team_name = t.team_name
+		LEFT JOIN merged m ON m.team_name = t.team_name
+		LEFT JOIN no_candidate n ON n.team_name = t.team_name
+		WHERE $1::text IS NULL OR t.team_name = $1
+		ORDER BY t.team_name;
+	`
+
+	// Недели считаются по UTC и начинаются с понедельника (date_trunc('week')).
+	getTeamWeeklyStatsQuery = `
+		SELECT team_name, week,
+		       COUNT(*) FILTER (WHERE kind = 'created'),
+		       COUNT(*) FILTER (WHERE kind = 'merged')
+		FROM (
+			SELECT team_name, date_trunc('week', created_at AT TIME ZONE 'UTC') AS week, 'created' AS kind
+			FROM pull_requests
+			WHERE team_name IS NOT NULL
+				AND ($1::text IS NULL OR team_name = $1)
+				AND ($2::timestamptz IS NULL OR created_at >= $2)
+				AND ($3::timestamptz IS NULL OR created_at < $3)
+			UNION ALL
+			SELECT team_name, date_trunc('week', merged_at AT TIME ZONE 'UTC'), 'merged'
+			FROM pull_requests
+			WHERE team_name IS NOT NULL AND merged_at IS NOT NULL
+				AND ($1::text IS NULL OR team_name = $1)
+				AND ($2::timestamptz IS NULL OR merged_at >= $2)
+				AND ($3::timestamptz IS NULL OR merged_at < $3)
+		) activity
+		GROUP BY team_name, week
+		ORDER BY team_name, week;
+	`
+
+	// Нагрузка — число назначений (ASSIGNED) на PR команды за окно. Население — текущие активные
+	// участники команды, включая тех, у кого назначений нет. Срез 'all' считается по всем назначениям,
+	// остальные — по стратегии, с которой было сделано назначение.
+	// Gini = 2·Σ(i·x_i) / (n·Σx) − (n + 1) / n, где x упорядочены по возрастанию, i — ранг с 1.
+	// Каждая строка результата — участник среза с метриками всего среза.
+	getFairnessQuery = `
+		WITH members AS (
+			SELECT m.team_name, u.user_id, u.username
+			FROM team_memberships m
+			JOIN users u ON u.user_id = m.user_id
+			WHERE u.is_active = TRUE AND ($1::text IS NULL OR m.team_name = $1)
+		),
+		loads AS (
+			SELECT e.team_name, e.user_id,
+			       CASE WHEN GROUPING(COALESCE(e.strategy, 'unknown')) = 1 THEN 'all'
+			            ELSE COALESCE(e.strategy, 'unknown') END AS strategy,
+			       COUNT(*) AS assignments
+			FROM review_events e
+			WHERE e.event_type = 'ASSIGNED'
+				AND e.team_name IS NOT NULL
+				AND ($1::text IS NULL OR e.team_name = $1)
+				AND ($2::timestamptz IS NULL OR e.created_at >= $2)
+				AND ($3::timestamptz IS NULL OR e.created_at < $3)
+			GROUP BY GROUPING SETS ((e.team_name, e.user_id, COALESCE(e.strategy, 'unknown')), (e.team_name, e.user_id))
+		),
+		slices AS (
+			SELECT DISTINCT team_name, 'all' AS strategy FROM members
+			UNION
+			SELECT DISTINCT team_name, strategy FROM loads
+		),
+		population AS (
+			SELECT m.team_name, s.strategy, m.user_id, m.username, COALESCE(l.assignments, 0) AS assignments
+			FROM members m
+			JOIN slices s ON s.team_name = m.team_name
+			LEFT JOIN loads l ON l.team_name = m.team_name AND l.user_id = m.user_id AND l.strategy = s.strategy
+		),
+		ranked AS (
+			SELECT p.*,
+			       ROW_NUMBER() OVER w_ordered AS position,
+			       COUNT(*) OVER w AS members,
+			       SUM(p.assignments) OVER w AS total,
+			       MIN(p.assignments) OVER w AS min_assignments,
+			       MAX(p.assignments) OVER w AS max_assignments,
+			       AVG(p.assignments) OVER w AS mean,
+			       STDDEV_POP(p.assignments) OVER w AS std_dev
+			FROM population p
+			WINDOW w AS (PARTITION BY p.team_name, p.strategy),
+			       w_ordered AS (PARTITION BY p.team_name, p.strategy ORDER BY p.assignments, p.user_id)
+		),
+		gini AS (
+			SELECT team_name, strategy,
+			       CASE WHEN MAX(total) = 0 THEN NULL
+			            ELSE 2.0 * SUM(position * assignments) / (MAX(members) * MAX(total)) - (MAX(members) + 1.0) / MAX(members)
+			       END AS gini
+			FROM ranked
+			GROUP BY team_name, strategy
+		)
+		SELECT r.team_name, r.strategy, r.user_id, r.username, r.assignments,
+		       CASE WHEN r.std_dev > 0 THEN (r.assignments - r.mean) / r.std_dev ELSE 0 END::float8 AS z_score,
+		       r.members, r.total, r.min_assignments, r.max_assignments,
+		       r.mean::float8, r.std_dev::float8, g.gini::float8,
+		       (r.max_assignments::float8 / NULLIF(r.min_assignments, 0)) AS max_min_ratio
+		FROM ranked r
+		JOIN gini g ON g.team_name = r.team_name AND g.strategy = r.strategy
+		ORDER BY r.team_name, r.strategy = 'all' DESC, r.strategy, r.assignments DESC, r.user_id;
+	`
+)
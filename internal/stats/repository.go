@@ -0,0 +1,163 @@
+package stats
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StatsRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewStatsRepository(db *pgxpool.Pool) *StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+// GetReviewerStats возвращает статистику ревьюверов за окно, упорядоченную по user_id.
+func (r *StatsRepository) GetReviewerStats(ctx context.Context, tx pgx.Tx, params api.GetStatsReviewersParams) ([]api.ReviewerStats, error) {
+	const op = "stats.repository.GetReviewerStats"
+
+	rows, err := tx.Query(ctx, getReviewerStatsQuery, params.TeamName, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	reviewers := []api.ReviewerStats{}
+	for rows.Next() {
+		var s api.ReviewerStats
+		if err := rows.Scan(
+			&s.UserId,
+			&s.Username,
+			&s.TeamName,
+			&s.IsActive,
+			&s.OpenReviews,
+			&s.TotalAssignments,
+			&s.ReassignedAway,
+			&s.MergedReviews,
+			&s.AvgHoursToMerge,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		reviewers = append(reviewers, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return reviewers, nil
+}
+
+// GetTeamStats возвращает метрики команд за окно вместе с понедельной разбивкой, упорядоченные по имени команды.
+func (r *StatsRepository) GetTeamStats(ctx context.Context, tx pgx.Tx, params api.GetStatsTeamsParams) ([]api.TeamStats, error) {
+	const op = "stats.repository.GetTeamStats"
+
+	rows, err := tx.Query(ctx, getTeamStatsQuery, params.TeamName, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	teams := []api.TeamStats{}
+	index := make(map[string]int)
+	for rows.Next() {
+		t := api.TeamStats{Weeks: []api.TeamWeekStats{}}
+		if err := rows.Scan(
+			&t.TeamName,
+			&t.PrsCreated,
+			&t.PrsMerged,
+			&t.MedianHoursToMerge,
+			&t.P90HoursToMerge,
+			&t.ShareUnderTwoReviewers,
+			&t.NoCandidateEvents,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		index[t.TeamName] = len(teams)
+		teams = append(teams, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	weekRows, err := tx.Query(ctx, getTeamWeeklyStatsQuery, params.TeamName, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer weekRows.Close()
+
+	for weekRows.Next() {
+		var teamName string
+		var week api.TeamWeekStats
+		if err := weekRows.Scan(&teamName, &week.WeekStart, &week.PrsCreated, &week.PrsMerged); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if i, ok := index[teamName]; ok {
+			teams[i].Weeks = append(teams[i].Weeks, week)
+		}
+	}
+	if err := weekRows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return teams, nil
+}
+
+// GetFairness возвращает распределение нагрузки по срезам команда × стратегия.
+// Участники с |z-score| не меньше params.ZThreshold попадают в выбросы.
+func (r *StatsRepository) GetFairness(ctx context.Context, tx pgx.Tx, params api.GetStatsFairnessParams) ([]api.TeamFairness, error) {
+	const op = "stats.repository.GetFairness"
+
+	rows, err := tx.Query(ctx, getFairnessQuery, params.TeamName, params.From, params.To)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	fairness := []api.TeamFairness{}
+	for rows.Next() {
+		var slice api.TeamFairness
+		var outlier api.FairnessOutlier
+		if err := rows.Scan(
+			&slice.TeamName,
+			&slice.Strategy,
+			&outlier.UserId,
+			&outlier.Username,
+			&outlier.Assignments,
+			&outlier.ZScore,
+			&slice.Members,
+			&slice.TotalAssignments,
+			&slice.MinAssignments,
+			&slice.MaxAssignments,
+			&slice.MeanAssignments,
+			&slice.StdDev,
+			&slice.Gini,
+			&slice.MaxMinRatio,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Строки отсортированы по срезам, поэтому новый срез начинается при смене команды или стратегии
+		last := len(fairness) - 1
+		if last < 0 || fairness[last].TeamName != slice.TeamName || fairness[last].Strategy != slice.Strategy {
+			slice.Outliers = []api.FairnessOutlier{}
+			fairness = append(fairness, slice)
+			last++
+		}
+		if math.Abs(outlier.ZScore) >= params.ZThreshold {
+			fairness[last].Outliers = append(fairness[last].Outliers, outlier)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return fairness, nil
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.StatsRepository = (*StatsRepository)(nil)
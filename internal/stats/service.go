@@ -0,0 +1,227 @@
+package stats
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Service struct {
+	statsRepo types.StatsRepository
+	teamRepo  types.TeamRepository
+	db        *pgxpool.Pool
+	logger    *slog.Logger
+}
+
+func NewService(
+	statsRepo types.StatsRepository,
+	teamRepo types.TeamRepository,
+	db *pgxpool.Pool,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		statsRepo: statsRepo,
+		teamRepo:  teamRepo,
+		db:        db,
+		logger:    logger,
+	}
+}
+
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// GetReviewerStats возвращает нагрузку и скорость ревьюверов за окно [From, To).
+// С TeamName в отчет попадают участники команды, а счетчики учитывают только PR этой команды.
+func (s *Service) GetReviewerStats(ctx context.Context, params api.GetStatsReviewersParams) (report *api.ReviewerStatsReport, err error) {
+	const op = "stats.service.GetReviewerStats"
+
+	if err := validateWindow(params.From, params.To); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	if params.TeamName != nil {
+		exists, err := s.teamRepo.Exists(ctx, tx, *params.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return nil, types.ErrNotFound
+		}
+	}
+
+	reviewers, err := s.statsRepo.GetReviewerStats(ctx, tx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &api.ReviewerStatsReport{
+		TeamName:  params.TeamName,
+		From:      params.From,
+		To:        params.To,
+		Reviewers: reviewers,
+	}, nil
+}
+
+// GetTeamStats возвращает пропускную способность и время цикла команд за окно [From, To).
+func (s *Service) GetTeamStats(ctx context.Context, params api.GetStatsTeamsParams) (report *api.TeamStatsReport, err error) {
+	const op = "stats.service.GetTeamStats"
+
+	if err := validateWindow(params.From, params.To); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	if params.TeamName != nil {
+		exists, err := s.teamRepo.Exists(ctx, tx, *params.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return nil, types.ErrNotFound
+		}
+	}
+
+	teams, err := s.statsRepo.GetTeamStats(ctx, tx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &api.TeamStatsReport{
+		TeamName: params.TeamName,
+		From:     params.From,
+		To:       params.To,
+		Teams:    teams,
+	}, nil
+}
+
+// GetTeamStatsCSV возвращает отчет GetTeamStats в CSV: по строке на неделю команды,
+// метрики за все окно повторяются в каждой строке команды. Команды без активности
+// попадают в отчет одной строкой с пустой неделей.
+func (s *Service) GetTeamStatsCSV(ctx context.Context, params api.GetStatsTeamsParams) ([]byte, error) {
+	const op = "stats.service.GetTeamStatsCSV"
+
+	report, err := s.GetTeamStats(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err := writeTeamStatsCSV(report.Teams)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return data, nil
+}
+
+// GetFairness оценивает, насколько равномерно распределены назначения на ревью внутри команд за окно [From, To).
+// Для каждой команды отчет содержит срез по всем назначениям и отдельные срезы по стратегиям выбора.
+func (s *Service) GetFairness(ctx context.Context, params api.GetStatsFairnessParams) (report *api.FairnessReport, err error) {
+	const op = "stats.service.GetFairness"
+
+	if err := validateWindow(params.From, params.To); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if params.ZThreshold == 0 {
+		params.ZThreshold = api.DefaultFairnessZThreshold
+	}
+	if params.ZThreshold < 0 {
+		return nil, fmt.Errorf("%s: z_threshold must be positive: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	if params.TeamName != nil {
+		exists, err := s.teamRepo.Exists(ctx, tx, *params.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return nil, types.ErrNotFound
+		}
+	}
+
+	teams, err := s.statsRepo.GetFairness(ctx, tx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &api.FairnessReport{
+		TeamName:   params.TeamName,
+		From:       params.From,
+		To:         params.To,
+		ZThreshold: params.ZThreshold,
+		Teams:      teams,
+	}, nil
+}
+
+// validateWindow проверяет, что окно [from, to) не пустое. nil-границы допустимы.
+func validateWindow(from, to *time.Time) error {
+	if from != nil && to != nil && !from.Before(*to) {
+		return fmt.Errorf("from must be before to: %w", types.ErrInvalidInput)
+	}
+	return nil
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.StatsService = (*Service)(nil)
@@ -0,0 +1,177 @@
+package stream
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Параметры Hub.
+const (
+	// subscriberBuffer — сколько событий ждет медленного клиента, прежде чем поток будет закрыт.
+	subscriberBuffer = 16
+	// maxListenBackoff ограничивает паузу между попытками переподключиться к БД.
+	maxListenBackoff = 30 * time.Second
+)
+
+// Hub слушает канал review_stream на выделенном соединении и раздает изменения потокам
+// пользователей этого экземпляра. Изменения с других экземпляров приходят через тот же канал.
+type Hub struct {
+	repo   *Repository
+	logger *slog.Logger
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan api.ReviewStreamEvent]struct{}
+	closed      bool
+}
+
+func NewHub(repo *Repository, logger *slog.Logger) *Hub {
+	return &Hub{
+		repo:        repo,
+		logger:      logger,
+		subscribers: make(map[string]map[chan api.ReviewStreamEvent]struct{}),
+	}
+}
+
+// Subscribe подписывает поток на изменения очереди пользователя. Канал закрывается после
+// отписки, при остановке Hub или если клиент не успевает читать события.
+func (h *Hub) Subscribe(userID string) (<-chan api.ReviewStreamEvent, func()) {
+	events := make(chan api.ReviewStreamEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(events)
+		return events, func() {}
+	}
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan api.ReviewStreamEvent]struct{})
+	}
+	h.subscribers[userID][events] = struct{}{}
+
+	return events, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(userID, events)
+	}
+}
+
+// Close закрывает все потоки и больше не принимает подписки. Вызывается при остановке
+// сервера: иначе открытые потоки не дадут ему дождаться завершения запросов.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for userID, subscribers := range h.subscribers {
+		for events := range subscribers {
+			h.remove(userID, events)
+		}
+	}
+}
+
+// Run слушает канал, пока не отменен ctx. После разрыва соединения Hub переподключается
+// и отправляет всем потокам resync: изменения за время разрыва потеряны.
+func (h *Hub) Run(ctx context.Context) {
+	backoff := time.Second
+	for {
+		started := time.Now()
+		err := h.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// Соединение, проработавшее дольше паузы, считается восстановленным
+		if time.Since(started) > maxListenBackoff {
+			backoff = time.Second
+		}
+		h.logger.ErrorContext(ctx, "review stream listener failed", utils.Err(err), "retry_in", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxListenBackoff)
+	}
+}
+
+// listen подписывается на канал и раздает уведомления до первой ошибки.
+func (h *Hub) listen(ctx context.Context) error {
+	const op = "stream.hub.listen"
+
+	conn, err := h.repo.Listen(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	h.broadcastResync()
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		var event api.ReviewStreamEvent
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			h.logger.WarnContext(ctx, "invalid review stream notification", utils.Err(err))
+			continue
+		}
+		h.dispatch(event)
+	}
+}
+
+// dispatch отправляет событие потокам пользователя. Поток, чей буфер заполнен, закрывается:
+// клиент переподключится и перечитает очередь, а остальные потоки не ждут его.
+func (h *Hub) dispatch(event api.ReviewStreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for events := range h.subscribers[event.UserId] {
+		select {
+		case events <- event:
+		default:
+			h.logger.Warn("review stream subscriber is too slow, closing", "user_id", event.UserId)
+			h.remove(event.UserId, events)
+		}
+	}
+}
+
+// broadcastResync сообщает всем потокам, что изменения могли быть пропущены.
+func (h *Hub) broadcastResync() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now().UTC()
+	for userID, subscribers := range h.subscribers {
+		for events := range subscribers {
+			select {
+			case events <- api.ReviewStreamEvent{Type: api.ReviewStreamRESYNC, UserId: userID, OccurredAt: now}:
+			default:
+				h.remove(userID, events)
+			}
+		}
+	}
+}
+
+// remove отписывает и закрывает поток, если он еще подписан. Вызывается под h.mu.
+func (h *Hub) remove(userID string, events chan api.ReviewStreamEvent) {
+	subscribers, ok := h.subscribers[userID]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[events]; !ok {
+		return
+	}
+
+	delete(subscribers, events)
+	close(events)
+	if len(subscribers) == 0 {
+		delete(h.subscribers, userID)
+	}
+}
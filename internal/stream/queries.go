@@ -0,0 +1,12 @@
+package stream
+
+var (
+	// pg_notify в транзакции доставляется слушателям только после ее фиксации
+	notifyQuery = `
+		SELECT pg_notify($1, $2);
+	`
+
+	listenQuery = `
+		LISTEN review_stream;
+	`
+)
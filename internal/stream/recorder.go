@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Recorder переводит доменные события в изменения очередей ревью и отправляет их через
+// NOTIFY в транзакции события: откаченное изменение до потоков не доходит.
+type Recorder struct {
+	repo *Repository
+}
+
+func NewRecorder(repo *Repository) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+func (r *Recorder) Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error {
+	const op = "stream.recorder.Record"
+
+	for _, change := range changesOf(event) {
+		payload, err := json.Marshal(change)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := r.repo.Notify(ctx, tx, payload); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// changesOf возвращает изменения очередей ревьюверов, затронутых событием: назначение
+// и снятие ревьювера и смену статуса PR, который они ревьюят.
+func changesOf(event api.DomainEvent) []api.ReviewStreamEvent {
+	change := func(eventType api.ReviewStreamEventType, userID string) api.ReviewStreamEvent {
+		return api.ReviewStreamEvent{
+			Id:            event.Id,
+			Type:          eventType,
+			UserId:        userID,
+			PullRequestId: event.PullRequestId,
+			OccurredAt:    event.OccurredAt,
+		}
+	}
+
+	var changes []api.ReviewStreamEvent
+	switch event.Type {
+	case api.DomainEventREVIEWERASSIGNED:
+		changes = append(changes, change(api.ReviewStreamASSIGNED, event.ReviewerId))
+	case api.DomainEventREVIEWERUNASSIGNED:
+		changes = append(changes, change(api.ReviewStreamUNASSIGNED, event.ReviewerId))
+	case api.DomainEventREVIEWERREASSIGNED:
+		changes = append(changes, change(api.ReviewStreamUNASSIGNED, event.OldReviewerId))
+		if event.ReviewerId != "" {
+			changes = append(changes, change(api.ReviewStreamASSIGNED, event.ReviewerId))
+		}
+	case api.DomainEventPRMERGED, api.DomainEventPRCLOSED, api.DomainEventPRREOPENED:
+		if event.PullRequest == nil {
+			return nil
+		}
+		for _, reviewerID := range event.PullRequest.AssignedReviewers {
+			c := change(api.ReviewStreamSTATUSCHANGED, reviewerID)
+			c.Status = event.PullRequest.Status
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.EventRecorder = (*Recorder)(nil)
@@ -0,0 +1,49 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// notifyChannel — канал LISTEN/NOTIFY, через который экземпляры сервиса обмениваются
+// изменениями очередей ревью; должен совпадать с каналом в listenQuery.
+const notifyChannel = "review_stream"
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Notify отправляет payload слушателям канала после фиксации tx.
+func (r *Repository) Notify(ctx context.Context, tx pgx.Tx, payload []byte) error {
+	const op = "stream.repository.Notify"
+
+	if _, err := tx.Exec(ctx, notifyQuery, notifyChannel, string(payload)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Listen забирает из пула отдельное соединение и подписывает его на канал. Соединение
+// не возвращается в пул: после отписки вызывающий закрывает его сам.
+func (r *Repository) Listen(ctx context.Context) (*pgx.Conn, error) {
+	const op = "stream.repository.Listen"
+
+	pooled, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	conn := pooled.Hijack()
+
+	if _, err := conn.Exec(ctx, listenQuery); err != nil {
+		conn.Close(context.WithoutCancel(ctx))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return conn, nil
+}
@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// heartbeatInterval — период комментариев-пингов в потоке. Они не дают прокси закрыть
+// простаивающее соединение и позволяют заметить отключившегося клиента.
+const heartbeatInterval = 15 * time.Second
+
+type Handler struct {
+	hub         *Hub
+	userService types.UserService
+	logger      *slog.Logger
+}
+
+func NewHandler(hub *Hub, userService types.UserService, logger *slog.Logger) *Handler {
+	return &Handler{
+		hub:         hub,
+		userService: userService,
+		logger:      logger,
+	}
+}
+
+func (h *Handler) log(r *http.Request) *slog.Logger {
+	return logging.FromContext(r.Context(), h.logger)
+}
+
+// GetUsersReviewStream отдает изменения очереди ревью пользователя как Server-Sent Events.
+// Имя SSE-события совпадает с type, id — с ID доменного события.
+func (h *Handler) GetUsersReviewStream(w http.ResponseWriter, r *http.Request, params api.GetUsersReviewStreamParams) {
+	userID := params.UserId
+	if _, err := h.userService.GetUser(r.Context(), userID); err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+
+	// WriteTimeout сервера оборвал бы поток, поэтому для него дедлайн записи снимается
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		utils.WriteError(w, h.log(r), http.StatusInternalServerError, fmt.Errorf("streaming is not supported: %w", err))
+		return
+	}
+
+	events, unsubscribe := h.hub.Subscribe(userID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 3000\n\n")
+	if err := rc.Flush(); err != nil {
+		return
+	}
+	h.log(r).InfoContext(r.Context(), "review stream opened", "user_id", userID)
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			h.log(r).InfoContext(r.Context(), "review stream closed by client", "user_id", userID)
+			return
+		case event, ok := <-events:
+			if !ok {
+				// Поток закрыт сервером: клиент переподключится и получит resync
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				h.log(r).ErrorContext(r.Context(), "failed to write review stream event", utils.Err(err))
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent пишет событие в формате SSE.
+func writeEvent(w http.ResponseWriter, event api.ReviewStreamEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if event.Id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", event.Id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
+
+func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, types.ErrNotFound) {
+		resp := api.ErrorResponse{}
+		resp.Error.Code = api.NOTFOUND
+		resp.Error.Message = "user not found"
+		if err := utils.WriteJson(w, http.StatusNotFound, resp); err != nil {
+			h.log(r).ErrorContext(r.Context(), "failed to write error response", utils.Err(err))
+		}
+		return
+	}
+	h.log(r).ErrorContext(r.Context(), "Internal Server Error", "error", err, "path", r.URL.Path)
+	utils.WriteError(w, h.log(r), http.StatusInternalServerError, err)
+}
@@ -0,0 +1,139 @@
+package team
+
+import (
+	"bytes"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Колонки CSV-файла с составом команд. is_active необязательна, по умолчанию true.
+const (
+	columnUserID   = "user_id"
+	columnUsername = "username"
+	columnTeamName = "team_name"
+	columnIsActive = "is_active"
+)
+
+var rosterColumns = []string{columnUserID, columnUsername, columnTeamName, columnIsActive}
+
+// parseRosterCSV разбирает CSV с заголовком. Порядок колонок произвольный,
+// разделителем может быть запятая или точка с запятой (так сохраняет Excel в русской локали).
+// Ошибки отдельных строк возвращаются списком, ошибка — только если файл нельзя разобрать целиком.
+func parseRosterCSV(r io.Reader) ([]api.RosterRow, []api.RosterRowError, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv: %w: %w", err, types.ErrInvalidInput)
+	}
+
+	// Excel добавляет BOM в начало UTF-8 файлов
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if firstLine, _, _ := bytes.Cut(data, []byte("\n")); bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("csv file is empty: %w", types.ErrInvalidInput)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("read csv header: %w: %w", err, types.ErrInvalidInput)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{columnUserID, columnUsername, columnTeamName} {
+		if _, ok := index[column]; !ok {
+			return nil, nil, fmt.Errorf("csv header must contain column %s: %w", column, types.ErrInvalidInput)
+		}
+	}
+
+	var rows []api.RosterRow
+	var rowErrs []api.RosterRowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrs = append(rowErrs, api.RosterRowError{Line: parseErr.Line, Message: parseErr.Err.Error()})
+				continue
+			}
+			return nil, nil, fmt.Errorf("read csv: %w: %w", err, types.ErrInvalidInput)
+		}
+
+		field := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := api.RosterRow{
+			Line:     line,
+			UserId:   field(columnUserID),
+			Username: field(columnUsername),
+			TeamName: field(columnTeamName),
+			IsActive: true,
+		}
+
+		var problems []string
+		for _, column := range []string{columnUserID, columnUsername, columnTeamName} {
+			if field(column) == "" {
+				problems = append(problems, column+" is required")
+			}
+		}
+		if v := field(columnIsActive); v != "" {
+			isActive, err := strconv.ParseBool(strings.ToLower(v))
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("is_active must be true or false, got %q", v))
+			}
+			row.IsActive = isActive
+		}
+
+		if len(problems) > 0 {
+			rowErrs = append(rowErrs, api.RosterRowError{Line: line, UserId: row.UserId, Message: strings.Join(problems, "; ")})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrs, nil
+}
+
+// writeRosterCSV сериализует состав команд в CSV с заголовком.
+func writeRosterCSV(rows []api.RosterRow) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(rosterColumns); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		record := []string{row.UserId, row.Username, row.TeamName, strconv.FormatBool(row.IsActive)}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -5,7 +5,125 @@ var (
 		INSERT INTO teams (team_name) VALUES ($1) ON CONFLICT (team_name) DO NOTHING;
 	`
 
+	listTeamNamesQuery = `
+		SELECT team_name FROM teams ORDER BY team_name;
+	`
+
+	listRosterQuery = `
+		SELECT u.user_id, u.username, m.team_name, u.is_active
+		FROM team_memberships m
+		JOIN users u ON u.user_id = m.user_id
+		ORDER BY m.team_name, u.user_id;
+	`
+
+	teamExistsQuery = `
+		SELECT EXISTS (SELECT 1 FROM teams WHERE team_name = $1);
+	`
+
+	renameTeamQuery = `
+		UPDATE teams SET team_name = $2 WHERE team_name = $1;
+	`
+
+	deleteTeamMembershipsQuery = `
+		DELETE FROM team_memberships WHERE team_name = $1;
+	`
+
+	// У участников, для которых команда была основной, основной становится любая из оставшихся.
+	detachTeamMembersQuery = `
+		UPDATE users u
+		SET team_name = (
+			SELECT m.team_name FROM team_memberships m WHERE m.user_id = u.user_id ORDER BY m.team_name LIMIT 1
+		)
+		WHERE u.team_name = $1;
+	`
+
+	// Дочерние команды переходят к родителю удаляемой и забирают ее собственные настройки там,
+	// где не заданы свои, поэтому их итоговые настройки не меняются.
+	reparentChildTeamsQuery = `
+		UPDATE teams c
+		SET parent_team = d.parent_team,
+		    reviewer_count = COALESCE(c.reviewer_count, d.reviewer_count),
+		    review_strategy = COALESCE(c.review_strategy, d.review_strategy),
+		    review_sla_hours = COALESCE(c.review_sla_hours, d.review_sla_hours)
+		FROM teams d
+		WHERE d.team_name = $1 AND c.parent_team = $1
+		RETURNING c.team_name;
+	`
+
+	deleteTeamQuery = `
+		DELETE FROM teams WHERE team_name = $1;
+	`
+
+	countTeamOpenReviewsQuery = `
+		SELECT COUNT(*)
+		FROM reviewers rev
+		JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+		WHERE pr.team_name = $1 AND pr.status = 'OPEN';
+	`
+
+	getTeamNodeQuery = `
+		SELECT team_name, parent_team, reviewer_count, review_strategy, review_sla_hours
+		FROM teams
+		WHERE team_name = $1;
+	`
+
+	setTeamParentQuery = `
+		UPDATE teams SET parent_team = $2 WHERE team_name = $1;
+	`
+
+	setTeamSettingsQuery = `
+		UPDATE teams SET reviewer_count = $2, review_strategy = $3, review_sla_hours = $4 WHERE team_name = $1;
+	`
+
+	// Цепочка предков от ближайшего к корню. Глубина ограничена на случай цикла в данных.
+	getTeamAncestorsQuery = `
+		WITH RECURSIVE chain AS (
+			SELECT parent_team, 1 AS depth FROM teams WHERE team_name = $1
+			UNION ALL
+			SELECT t.parent_team, c.depth + 1
+			FROM teams t
+			JOIN chain c ON t.team_name = c.parent_team
+			WHERE c.depth < 32
+		)
+		SELECT parent_team FROM chain WHERE parent_team IS NOT NULL ORDER BY depth;
+	`
+
+	getEffectiveTeamSettingsQuery = `
+		WITH RECURSIVE chain AS (
+			SELECT team_name, parent_team, reviewer_count, review_strategy, review_sla_hours, 0 AS depth
+			FROM teams WHERE team_name = $1
+			UNION ALL
+			SELECT t.team_name, t.parent_team, t.reviewer_count, t.review_strategy, t.review_sla_hours, c.depth + 1
+			FROM teams t
+			JOIN chain c ON t.team_name = c.parent_team
+			WHERE c.depth < 32
+		)
+		SELECT
+			(SELECT reviewer_count FROM chain WHERE reviewer_count IS NOT NULL ORDER BY depth LIMIT 1),
+			(SELECT review_strategy FROM chain WHERE review_strategy IS NOT NULL ORDER BY depth LIMIT 1),
+			(SELECT review_sla_hours FROM chain WHERE review_sla_hours IS NOT NULL ORDER BY depth LIMIT 1);
+	`
+
+	getTeamSubtreeQuery = `
+		WITH RECURSIVE subtree AS (
+			SELECT team_name, parent_team, reviewer_count, review_strategy, review_sla_hours, 0 AS depth
+			FROM teams WHERE team_name = $1
+			UNION ALL
+			SELECT t.team_name, t.parent_team, t.reviewer_count, t.review_strategy, t.review_sla_hours, s.depth + 1
+			FROM teams t
+			JOIN subtree s ON t.parent_team = s.team_name
+			WHERE s.depth < 32
+		)
+		SELECT team_name, parent_team, reviewer_count, review_strategy, review_sla_hours
+		FROM subtree
+		ORDER BY depth, team_name;
+	`
+
 	getByNameTeamQuery = `
-		SELECT user_id, username, is_active FROM users WHERE team_name = $1;
+		SELECT u.user_id, u.username, u.is_active
+		FROM users u
+		JOIN team_memberships m ON m.user_id = u.user_id
+		WHERE m.team_name = $1
+		ORDER BY u.user_id;
 	`
 )
@@ -5,6 +5,7 @@ import (
 	"deplagene/avito-tech-internship/cmd/api"
 	"deplagene/avito-tech-internship/internal/user"
 	"deplagene/avito-tech-internship/types"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
@@ -37,11 +38,18 @@ func (r *TeamRepository) Create(ctx context.Context, tx pgx.Tx, team api.Team) e
 	return nil
 }
 
-// GetByName возвращает команду и ее участников по имени.
+// GetByName возвращает команду и ее участников по имени. Возвращает nil, если команды не существует;
+// у команды без участников (например, подразделения или команды, из которой всех исключили) Members пуст.
 func (r *TeamRepository) GetByName(ctx context.Context, tx pgx.Tx, name string) (*api.Team, error) {
 	const op = "team.repository.GetByName"
 
-	team := &api.Team{TeamName: name}
+	exists, err := r.Exists(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, nil
+	}
 
 	rows, err := tx.Query(ctx, getByNameTeamQuery, name)
 	if err != nil {
@@ -49,7 +57,7 @@ func (r *TeamRepository) GetByName(ctx context.Context, tx pgx.Tx, name string)
 	}
 	defer rows.Close()
 
-	var members []api.TeamMember
+	members := []api.TeamMember{}
 	for rows.Next() {
 		var member api.TeamMember
 		if err := rows.Scan(&member.UserId, &member.Username, &member.IsActive); err != nil {
@@ -62,12 +70,250 @@ func (r *TeamRepository) GetByName(ctx context.Context, tx pgx.Tx, name string)
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if len(members) == 0 {
-		return nil, nil
+	return &api.Team{TeamName: name, Members: members}, nil
+}
+
+// ListNames возвращает имена всех команд по алфавиту.
+func (r *TeamRepository) ListNames(ctx context.Context, tx pgx.Tx) ([]string, error) {
+	const op = "team.repository.ListNames"
+
+	rows, err := tx.Query(ctx, listTeamNamesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return names, nil
+}
+
+// ListRoster возвращает все членства в командах, упорядоченные по команде и user_id.
+func (r *TeamRepository) ListRoster(ctx context.Context, tx pgx.Tx) ([]api.RosterRow, error) {
+	const op = "team.repository.ListRoster"
+
+	rows, err := tx.Query(ctx, listRosterQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var roster []api.RosterRow
+	for rows.Next() {
+		var row api.RosterRow
+		if err := rows.Scan(&row.UserId, &row.Username, &row.TeamName, &row.IsActive); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		roster = append(roster, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return roster, nil
+}
+
+// Exists проверяет, существует ли команда с указанным именем.
+func (r *TeamRepository) Exists(ctx context.Context, tx pgx.Tx, name string) (bool, error) {
+	const op = "team.repository.Exists"
+
+	var exists bool
+	if err := tx.QueryRow(ctx, teamExistsQuery, name).Scan(&exists); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
+// Rename переименовывает команду. users.team_name, team_memberships и pull_requests.team_name
+// обновляются каскадно по внешним ключам.
+func (r *TeamRepository) Rename(ctx context.Context, tx pgx.Tx, oldName, newName string) error {
+	const op = "team.repository.Rename"
+
+	if _, err := tx.Exec(ctx, renameTeamQuery, oldName, newName); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Delete удаляет команду, предварительно исключив из нее всех участников. Дочерние команды
+// переподвешиваются к ее родителю; возвращаются их имена.
+func (r *TeamRepository) Delete(ctx context.Context, tx pgx.Tx, name string) ([]string, error) {
+	const op = "team.repository.Delete"
+
+	if _, err := tx.Exec(ctx, deleteTeamMembershipsQuery, name); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.Exec(ctx, detachTeamMembersQuery, name); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := tx.Query(ctx, reparentChildTeamsQuery, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var children []string
+	for rows.Next() {
+		var child string
+		if err := rows.Scan(&child); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		children = append(children, child)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteTeamQuery, name); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return children, nil
+}
+
+// CountOpenReviews возвращает количество открытых ревью, назначенных участникам команды.
+func (r *TeamRepository) CountOpenReviews(ctx context.Context, tx pgx.Tx, name string) (int, error) {
+	const op = "team.repository.CountOpenReviews"
+
+	var count int
+	if err := tx.QueryRow(ctx, countTeamOpenReviewsQuery, name).Scan(&count); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return count, nil
+}
+
+// GetNode возвращает команду с родителем и собственными настройками, без участников.
+// Возвращает nil, если команды не существует.
+func (r *TeamRepository) GetNode(ctx context.Context, tx pgx.Tx, name string) (*api.TeamNode, error) {
+	const op = "team.repository.GetNode"
+
+	node, err := scanTeamNode(tx.QueryRow(ctx, getTeamNodeQuery, name))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return node, nil
+}
+
+// GetSubtree возвращает команду и всех ее потомков плоским списком: сначала корень,
+// затем уровни по возрастанию глубины. Участники и итоговые настройки не заполняются.
+func (r *TeamRepository) GetSubtree(ctx context.Context, tx pgx.Tx, name string) ([]api.TeamNode, error) {
+	const op = "team.repository.GetSubtree"
+
+	rows, err := tx.Query(ctx, getTeamSubtreeQuery, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var nodes []api.TeamNode
+	for rows.Next() {
+		node, err := scanTeamNode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		nodes = append(nodes, *node)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nodes, nil
+}
 
-	team.Members = members
-	return team, nil
+// GetAncestors возвращает имена команд-предков, начиная с непосредственного родителя.
+func (r *TeamRepository) GetAncestors(ctx context.Context, tx pgx.Tx, name string) ([]string, error) {
+	const op = "team.repository.GetAncestors"
+
+	rows, err := tx.Query(ctx, getTeamAncestorsQuery, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var ancestors []string
+	for rows.Next() {
+		var ancestor string
+		if err := rows.Scan(&ancestor); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ancestors = append(ancestors, ancestor)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ancestors, nil
+}
+
+// GetEffectiveSettings возвращает настройки команды с учетом наследования от предков.
+// Для несуществующей команды возвращаются значения по умолчанию.
+func (r *TeamRepository) GetEffectiveSettings(ctx context.Context, tx pgx.Tx, name string) (api.EffectiveTeamSettings, error) {
+	const op = "team.repository.GetEffectiveSettings"
+
+	var own api.TeamSettings
+	var strategy *string
+	if err := tx.QueryRow(ctx, getEffectiveTeamSettingsQuery, name).Scan(&own.ReviewerCount, &strategy, &own.SlaHours); err != nil {
+		return api.EffectiveTeamSettings{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if strategy != nil {
+		own.Strategy = api.Ptr(api.ReviewStrategy(*strategy))
+	}
+
+	return defaultSettings().Inherit(own), nil
+}
+
+// SetParent меняет родительскую команду. nil делает команду корнем.
+func (r *TeamRepository) SetParent(ctx context.Context, tx pgx.Tx, name string, parent *string) error {
+	const op = "team.repository.SetParent"
+
+	if _, err := tx.Exec(ctx, setTeamParentQuery, name, parent); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SetSettings перезаписывает собственные настройки команды.
+func (r *TeamRepository) SetSettings(ctx context.Context, tx pgx.Tx, name string, settings api.TeamSettings) error {
+	const op = "team.repository.SetSettings"
+
+	var strategy *string
+	if settings.Strategy != nil {
+		strategy = api.Ptr(string(*settings.Strategy))
+	}
+
+	if _, err := tx.Exec(ctx, setTeamSettingsQuery, name, settings.ReviewerCount, strategy, settings.SlaHours); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func scanTeamNode(row pgx.Row) (*api.TeamNode, error) {
+	node := &api.TeamNode{}
+	var strategy *string
+
+	if err := row.Scan(&node.TeamName, &node.ParentTeam, &node.Settings.ReviewerCount, &strategy, &node.Settings.SlaHours); err != nil {
+		return nil, err
+	}
+	if strategy != nil {
+		node.Settings.Strategy = api.Ptr(api.ReviewStrategy(*strategy))
+	}
+	return node, nil
+}
+
+func defaultSettings() api.EffectiveTeamSettings {
+	return api.EffectiveTeamSettings{
+		ReviewerCount: api.DefaultReviewerCount,
+		Strategy:      api.DefaultReviewStrategy,
+	}
 }
 
 // Проверка соответствия интерфейсу во время компиляции
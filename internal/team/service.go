@@ -3,34 +3,59 @@ package team
 import (
 	"context"
 	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
+	"deplagene/avito-tech-internship/internal/tracing"
 	"deplagene/avito-tech-internship/types"
 	"deplagene/avito-tech-internship/utils"
 	"fmt"
+	"io"
 	"log/slog"
+	"slices"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Service struct {
-	teamRepo types.TeamRepository
-	userRepo types.UserRepository
-	db       *pgxpool.Pool
-	logger   *slog.Logger
+	teamRepo   types.TeamRepository
+	userRepo   types.UserRepository
+	reassigner types.ReviewReassigner
+	events     types.EventRecorder
+	db         *pgxpool.Pool
+	logger     *slog.Logger
 }
 
-func NewService(teamRepo types.TeamRepository, userRepo types.UserRepository, db *pgxpool.Pool, logger *slog.Logger) *Service {
+func NewService(
+	teamRepo types.TeamRepository,
+	userRepo types.UserRepository,
+	reassigner types.ReviewReassigner,
+	events types.EventRecorder,
+	db *pgxpool.Pool,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		teamRepo: teamRepo,
-		userRepo: userRepo,
-		db:       db,
-		logger:   logger,
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		reassigner: reassigner,
+		events:     events,
+		db:         db,
+		logger:     logger,
 	}
 }
 
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
 // CreateTeam создает новую команду и ее участников.
+// Участники, уже состоящие в другой команде, отклоняются с ErrUserInOtherTeam.
 func (s *Service) CreateTeam(ctx context.Context, team api.Team) (createdTeam *api.Team, err error) {
 	const op = "team.service.CreateTeam"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -38,41 +63,75 @@ func (s *Service) CreateTeam(ctx context.Context, team api.Team) (createdTeam *a
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
 		} else {
 			err = tx.Commit(ctx)
 		}
 	}()
 
+	return s.CreateTeamTx(ctx, tx, team)
+}
+
+// CreateTeamTx выполняет CreateTeam в рамках внешней транзакции.
+func (s *Service) CreateTeamTx(ctx context.Context, tx pgx.Tx, team api.Team) (createdTeam *api.Team, err error) {
+	const op = "team.service.CreateTeamTx"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	// Переезд и вступление в дополнительную команду выполняются только явно,
+	// через /users/move и /team/addMember
+	for _, member := range team.Members {
+		memberTeams, err := s.userRepo.GetTeamsByUserID(ctx, tx, member.UserId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if len(memberTeams) > 0 && !slices.Contains(memberTeams, team.TeamName) {
+			return nil, fmt.Errorf("%s: user %s is in team %s: %w", op, member.UserId, memberTeams[0], types.ErrUserInOtherTeam)
+		}
+	}
+
 	existingTeam, err := s.teamRepo.GetByName(ctx, tx, team.TeamName)
 	if err != nil {
-		return createdTeam, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	if existingTeam == nil {
 		if err = s.teamRepo.Create(ctx, tx, team); err != nil {
-			return createdTeam, fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
 	for _, member := range team.Members {
 		if err = s.userRepo.Upsert(ctx, tx, member, team.TeamName); err != nil {
-			return createdTeam, fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
+	// Повторный CreateTeam существующей команды обновляет ее участников
+	eventType := api.DomainEventTEAMCREATED
+	if existingTeam != nil {
+		eventType = api.DomainEventTEAMUPDATED
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: eventType, TeamName: team.TeamName}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return &team, nil
 }
 
 // GetTeam возвращает команду по имени.
-func (s *Service) GetTeam(ctx context.Context, name string) (*api.Team, error) {
+func (s *Service) GetTeam(ctx context.Context, name string) (team *api.Team, err error) {
 	const op = "team.service.GetTeam"
 
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -80,19 +139,19 @@ func (s *Service) GetTeam(ctx context.Context, name string) (*api.Team, error) {
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
 		} else {
 			err = tx.Commit(ctx)
 		}
 	}()
 
-	team, err := s.teamRepo.GetByName(ctx, tx, name)
+	team, err = s.teamRepo.GetByName(ctx, tx, name)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -102,5 +161,695 @@ func (s *Service) GetTeam(ctx context.Context, name string) (*api.Team, error) {
 	return team, nil
 }
 
+// ListTeams возвращает имена всех команд.
+func (s *Service) ListTeams(ctx context.Context) (names []string, err error) {
+	const op = "team.service.ListTeams"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	names, err = s.teamRepo.ListNames(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return names, nil
+}
+
+// AddMember добавляет существующего пользователя в команду, сохраняя его членство в остальных командах.
+func (s *Service) AddMember(ctx context.Context, teamName, userID string) (team *api.Team, err error) {
+	const op = "team.service.AddMember"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.AddMemberTx(ctx, tx, teamName, userID)
+}
+
+// AddMemberTx выполняет AddMember в рамках внешней транзакции.
+func (s *Service) AddMemberTx(ctx context.Context, tx pgx.Tx, teamName, userID string) (team *api.Team, err error) {
+	const op = "team.service.AddMemberTx"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	exists, err := s.teamRepo.Exists(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	user, err := s.userRepo.GetByID(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, types.ErrNotFound
+	}
+
+	if err = s.userRepo.AddMembership(ctx, tx, userID, teamName); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{
+		Type:     api.DomainEventTEAMMEMBERADDED,
+		TeamName: teamName,
+		UserId:   userID,
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	team, err = s.teamRepo.GetByName(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return team, nil
+}
+
+// RemoveMember исключает пользователя из команды.
+// Его открытые ревью в PR этой команды переназначаются на оставшихся участников.
+func (s *Service) RemoveMember(ctx context.Context, teamName, userID string) (err error) {
+	const op = "team.service.RemoveMember"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	return s.RemoveMemberTx(ctx, tx, teamName, userID)
+}
+
+// RemoveMemberTx выполняет RemoveMember в рамках внешней транзакции.
+func (s *Service) RemoveMemberTx(ctx context.Context, tx pgx.Tx, teamName, userID string) (err error) {
+	const op = "team.service.RemoveMemberTx"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	removed, err := s.userRepo.RemoveFromTeam(ctx, tx, userID, teamName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !removed {
+		return types.ErrNotFound
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{
+		Type:     api.DomainEventTEAMMEMBERREMOVED,
+		TeamName: teamName,
+		UserId:   userID,
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.reassigner.ReassignOpenReviews(ctx, tx, userID, teamName); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RenameTeam переименовывает команду. Участники переезжают вместе с ней.
+func (s *Service) RenameTeam(ctx context.Context, oldName, newName string) (renamedTeam *api.Team, err error) {
+	const op = "team.service.RenameTeam"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, oldName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	if oldName != newName {
+		exists, err = s.teamRepo.Exists(ctx, tx, newName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if exists {
+			return nil, types.ErrAlreadyExists
+		}
+
+		if err = s.teamRepo.Rename(ctx, tx, oldName, newName); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err = s.events.Record(ctx, tx, api.DomainEvent{
+			Type:             api.DomainEventTEAMRENAMED,
+			TeamName:         newName,
+			PreviousTeamName: oldName,
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	renamedTeam, err = s.teamRepo.GetByName(ctx, tx, newName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if renamedTeam == nil {
+		return nil, types.ErrNotFound
+	}
+	return renamedTeam, nil
+}
+
+// DeleteTeam удаляет команду. Удаление запрещено, пока у PR команды есть назначенные ревьюверы.
+// Дочерние команды переходят к родителю удаленной, сохраняя унаследованные от нее настройки.
+func (s *Service) DeleteTeam(ctx context.Context, name string) (err error) {
+	const op = "team.service.DeleteTeam"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	return s.DeleteTeamTx(ctx, tx, name)
+}
+
+// DeleteTeamTx выполняет DeleteTeam в рамках внешней транзакции.
+func (s *Service) DeleteTeamTx(ctx context.Context, tx pgx.Tx, name string) (err error) {
+	const op = "team.service.DeleteTeamTx"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	exists, err := s.teamRepo.Exists(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return types.ErrNotFound
+	}
+
+	openReviews, err := s.teamRepo.CountOpenReviews(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if openReviews > 0 {
+		return types.ErrTeamHasOpenReviews
+	}
+
+	children, err := s.teamRepo.Delete(ctx, tx, name)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMDELETED, TeamName: name}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for _, child := range children {
+		if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMUPDATED, TeamName: child}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+// GetTeamTree возвращает команду вместе со всем поддеревом дочерних команд.
+// Итоговые настройки каждой команды рассчитываются с учетом наследования.
+func (s *Service) GetTeamTree(ctx context.Context, name string) (root *api.TeamNode, err error) {
+	const op = "team.service.GetTeamTree"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	nodes, err := s.teamRepo.GetSubtree(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(nodes) == 0 {
+		return nil, types.ErrNotFound
+	}
+
+	rootSettings, err := s.teamRepo.GetEffectiveSettings(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	children := make(map[string][]*api.TeamNode, len(nodes))
+	for i := range nodes {
+		if nodes[i].Members, err = s.getMembers(ctx, tx, nodes[i].TeamName); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if i > 0 && nodes[i].ParentTeam != nil {
+			children[*nodes[i].ParentTeam] = append(children[*nodes[i].ParentTeam], &nodes[i])
+		}
+	}
+
+	var build func(node *api.TeamNode, settings api.EffectiveTeamSettings) api.TeamNode
+	build = func(node *api.TeamNode, settings api.EffectiveTeamSettings) api.TeamNode {
+		node.EffectiveSettings = settings
+		for _, child := range children[node.TeamName] {
+			node.Subteams = append(node.Subteams, build(child, settings.Inherit(child.Settings)))
+		}
+		return *node
+	}
+
+	tree := build(&nodes[0], rootSettings)
+	return &tree, nil
+}
+
+// SetParentTeam перемещает команду в дереве. parent == nil делает команду корнем.
+func (s *Service) SetParentTeam(ctx context.Context, name string, parent *string) (node *api.TeamNode, err error) {
+	const op = "team.service.SetParentTeam"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	if parent != nil {
+		if *parent == name {
+			return nil, fmt.Errorf("%s: team cannot be its own parent: %w", op, types.ErrInvalidInput)
+		}
+
+		exists, err = s.teamRepo.Exists(ctx, tx, *parent)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return nil, types.ErrNotFound
+		}
+
+		ancestors, err := s.teamRepo.GetAncestors(ctx, tx, *parent)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if slices.Contains(ancestors, name) {
+			return nil, fmt.Errorf("%s: %s is a descendant of %s: %w", op, *parent, name, types.ErrInvalidInput)
+		}
+	}
+
+	if err = s.teamRepo.SetParent(ctx, tx, name, parent); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMUPDATED, TeamName: name}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	node, err = s.loadNode(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return node, nil
+}
+
+// UpdateTeamSettings перезаписывает собственные настройки команды.
+// Незаданные поля сбрасываются и снова наследуются от родительской команды.
+func (s *Service) UpdateTeamSettings(ctx context.Context, name string, settings api.TeamSettings) (node *api.TeamNode, err error) {
+	const op = "team.service.UpdateTeamSettings"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	if err := validateSettings(settings); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	exists, err := s.teamRepo.Exists(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	if err = s.teamRepo.SetSettings(ctx, tx, name, settings); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = s.events.Record(ctx, tx, api.DomainEvent{Type: api.DomainEventTEAMUPDATED, TeamName: name}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	node, err = s.loadNode(ctx, tx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return node, nil
+}
+
+// ImportTeamsCSV импортирует состав команд из CSV с семантикой CreateTeam:
+// пользователь, уже состоящий в другой команде, отклоняется. Как и в выгрузке, каждая строка —
+// одно членство: пользователь может быть указан в нескольких командах, но в каждой один раз.
+// Новый пользователь попадает в первую из своих команд, в остальные добавляется как через AddMember.
+// Файл применяется целиком и только если ни в одной строке нет ошибок; при dryRun изменения
+// не сохраняются в любом случае.
+func (s *Service) ImportTeamsCSV(ctx context.Context, r io.Reader, dryRun bool) (result *api.TeamImportResult, err error) {
+	const op = "team.service.ImportTeamsCSV"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	rows, rowErrs, err := parseRosterCSV(r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil || result == nil || !result.Applied {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	type membership struct{ userID, teamName string }
+
+	var teams []api.Team
+	var extraMemberships []membership
+	teamIndex := make(map[string]int)
+	membershipRows := make(map[membership]api.RosterRow, len(rows))
+	newUserListed := make(map[string]bool)
+	for _, row := range rows {
+		key := membership{userID: row.UserId, teamName: row.TeamName}
+		if seen, ok := membershipRows[key]; ok {
+			rowErrs = append(rowErrs, api.RosterRowError{
+				Line:    row.Line,
+				UserId:  row.UserId,
+				Message: fmt.Sprintf("user is already listed in team %s on line %d", row.TeamName, seen.Line),
+			})
+			continue
+		}
+		membershipRows[key] = row
+
+		memberTeams, err := s.userRepo.GetTeamsByUserID(ctx, tx, row.UserId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if len(memberTeams) > 0 && !slices.Contains(memberTeams, row.TeamName) {
+			rowErrs = append(rowErrs, api.RosterRowError{
+				Line:    row.Line,
+				UserId:  row.UserId,
+				Message: fmt.Sprintf("user belongs to team %s, use /users/move or /team/addMember", memberTeams[0]),
+			})
+			continue
+		}
+
+		i, ok := teamIndex[row.TeamName]
+		if !ok {
+			i = len(teams)
+			teamIndex[row.TeamName] = i
+			teams = append(teams, api.Team{TeamName: row.TeamName, Members: []api.TeamMember{}})
+		}
+
+		// CreateTeam не добавит нового пользователя во вторую команду, поэтому остальные
+		// его команды применяются после создания всех команд файла
+		if len(memberTeams) == 0 {
+			if newUserListed[row.UserId] {
+				extraMemberships = append(extraMemberships, key)
+				continue
+			}
+			newUserListed[row.UserId] = true
+		}
+		teams[i].Members = append(teams[i].Members, api.TeamMember{
+			UserId:   row.UserId,
+			Username: row.Username,
+			IsActive: row.IsActive,
+		})
+	}
+
+	slices.SortStableFunc(rowErrs, func(a, b api.RosterRowError) int { return a.Line - b.Line })
+	result = &api.TeamImportResult{
+		DryRun: dryRun,
+		Rows:   len(rows),
+		Teams:  len(teams),
+		Errors: rowErrs,
+	}
+	if result.Errors == nil {
+		result.Errors = []api.RosterRowError{}
+	}
+	if dryRun || len(rowErrs) > 0 {
+		return result, nil
+	}
+
+	for _, team := range teams {
+		if _, err = s.CreateTeamTx(ctx, tx, team); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	for _, m := range extraMemberships {
+		if _, err = s.AddMemberTx(ctx, tx, m.teamName, m.userID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	result.Applied = true
+	return result, nil
+}
+
+// ExportTeamsCSV выгружает все членства в командах в формате, который принимает ImportTeamsCSV.
+func (s *Service) ExportTeamsCSV(ctx context.Context) (data []byte, err error) {
+	const op = "team.service.ExportTeamsCSV"
+
+	ctx, span := tracing.Start(ctx, op)
+	defer tracing.End(span, &err)
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	rows, err := s.teamRepo.ListRoster(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err = writeRosterCSV(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return data, nil
+}
+
+// loadNode собирает узел дерева с участниками и итоговыми настройками, без поддерева.
+func (s *Service) loadNode(ctx context.Context, tx pgx.Tx, name string) (*api.TeamNode, error) {
+	node, err := s.teamRepo.GetNode(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, types.ErrNotFound
+	}
+
+	if node.Members, err = s.getMembers(ctx, tx, name); err != nil {
+		return nil, err
+	}
+	if node.EffectiveSettings, err = s.teamRepo.GetEffectiveSettings(ctx, tx, name); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// getMembers возвращает участников команды; у команды-подразделения их может не быть.
+func (s *Service) getMembers(ctx context.Context, tx pgx.Tx, name string) ([]api.TeamMember, error) {
+	team, err := s.teamRepo.GetByName(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+	if team == nil {
+		return []api.TeamMember{}, nil
+	}
+	return team.Members, nil
+}
+
+func validateSettings(settings api.TeamSettings) error {
+	if settings.ReviewerCount != nil && (*settings.ReviewerCount < 0 || *settings.ReviewerCount > 10) {
+		return fmt.Errorf("reviewer_count must be between 0 and 10: %w", types.ErrInvalidInput)
+	}
+	if settings.Strategy != nil {
+		switch *settings.Strategy {
+		case api.ReviewStrategyRANDOM, api.ReviewStrategyLEASTLOADED:
+		default:
+			return fmt.Errorf("unknown strategy %q: %w", *settings.Strategy, types.ErrInvalidInput)
+		}
+	}
+	if settings.SlaHours != nil && *settings.SlaHours <= 0 {
+		return fmt.Errorf("sla_hours must be positive: %w", types.ErrInvalidInput)
+	}
+	return nil
+}
+
 // Проверка соответствия интерфейсу во время компиляции
 var _ types.TeamService = (*Service)(nil)
+var _ types.TeamTxService = (*Service)(nil)
@@ -0,0 +1,135 @@
+package team_test
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/testdb"
+	"deplagene/avito-tech-internship/internal/user"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// eventLog запоминает типы записанных доменных событий.
+type eventLog struct {
+	mu    sync.Mutex
+	types []api.DomainEventType
+}
+
+func (l *eventLog) Record(_ context.Context, _ pgx.Tx, event api.DomainEvent) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.types = append(l.types, event.Type)
+	return nil
+}
+
+func (l *eventLog) last() api.DomainEventType {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.types) == 0 {
+		return ""
+	}
+	return l.types[len(l.types)-1]
+}
+
+func newTestService(t *testing.T) (*team.Service, *eventLog) {
+	t.Helper()
+
+	pool := testdb.New(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	teamRepo := team.NewTeamRepository(pool)
+	userRepo := user.NewUserRepository(pool)
+	log := &eventLog{}
+	recorder := events.NewRecorder(log)
+	prService := pullrequest.NewService(pullrequest.NewPullRequestRepository(pool), userRepo, teamRepo, recorder, pool, logger)
+	return team.NewService(teamRepo, userRepo, prService, recorder, pool, logger), log
+}
+
+// TestEmptyTeamIsFound проверяет, что команда, из которой исключили всех участников,
+// по-прежнему находится, переименовывается и при повторном создании считается обновленной.
+func TestEmptyTeamIsFound(t *testing.T) {
+	teamService, log := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := teamService.CreateTeam(ctx, api.Team{
+		TeamName: "backend",
+		Members:  []api.TeamMember{{UserId: "u1", Username: "Alice", IsActive: true}},
+	}); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	if err := teamService.RemoveMember(ctx, "backend", "u1"); err != nil {
+		t.Fatalf("remove member: %v", err)
+	}
+
+	got, err := teamService.GetTeam(ctx, "backend")
+	if err != nil {
+		t.Fatalf("get empty team: %v", err)
+	}
+	if got.Members == nil || len(got.Members) != 0 {
+		t.Errorf("members = %#v, want empty non-nil slice", got.Members)
+	}
+
+	if _, err := teamService.CreateTeam(ctx, api.Team{TeamName: "backend", Members: []api.TeamMember{}}); err != nil {
+		t.Fatalf("recreate team: %v", err)
+	}
+	if got := log.last(); got != api.DomainEventTEAMUPDATED {
+		t.Errorf("recreate recorded %s, want %s", got, api.DomainEventTEAMUPDATED)
+	}
+
+	renamed, err := teamService.RenameTeam(ctx, "backend", "platform")
+	if err != nil {
+		t.Fatalf("rename empty team: %v", err)
+	}
+	if renamed.TeamName != "platform" || len(renamed.Members) != 0 {
+		t.Errorf("renamed = %+v, want platform without members", renamed)
+	}
+}
+
+// TestParentTeamWithoutMembers проверяет, что команда-подразделение без собственных участников
+// находится по имени и отдает дерево с дочерней командой.
+func TestParentTeamWithoutMembers(t *testing.T) {
+	teamService, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := teamService.CreateTeam(ctx, api.Team{TeamName: "engineering", Members: []api.TeamMember{}}); err != nil {
+		t.Fatalf("create parent team: %v", err)
+	}
+	if _, err := teamService.CreateTeam(ctx, api.Team{
+		TeamName: "backend",
+		Members:  []api.TeamMember{{UserId: "u1", Username: "Alice", IsActive: true}},
+	}); err != nil {
+		t.Fatalf("create child team: %v", err)
+	}
+	if _, err := teamService.SetParentTeam(ctx, "backend", api.Ptr("engineering")); err != nil {
+		t.Fatalf("set parent: %v", err)
+	}
+
+	parent, err := teamService.GetTeam(ctx, "engineering")
+	if err != nil {
+		t.Fatalf("get parent team: %v", err)
+	}
+	if parent.Members == nil || len(parent.Members) != 0 {
+		t.Errorf("parent members = %#v, want empty non-nil slice", parent.Members)
+	}
+
+	tree, err := teamService.GetTeamTree(ctx, "engineering")
+	if err != nil {
+		t.Fatalf("get team tree: %v", err)
+	}
+	if len(tree.Members) != 0 {
+		t.Errorf("tree root members = %+v, want none", tree.Members)
+	}
+	if len(tree.Subteams) != 1 || tree.Subteams[0].TeamName != "backend" {
+		t.Fatalf("subteams = %+v, want [backend]", tree.Subteams)
+	}
+	if members := tree.Subteams[0].Members; len(members) != 1 || members[0].UserId != "u1" {
+		t.Errorf("backend members = %+v, want [u1]", members)
+	}
+}
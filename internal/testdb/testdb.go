@@ -0,0 +1,86 @@
+// Package testdb дает интеграционным тестам отдельную схему Postgres с примененными миграциями.
+// База берется из TEST_DATABASE_URL; без нее тесты пропускаются.
+package testdb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnvDatabaseURL — переменная окружения с адресом тестовой базы.
+const EnvDatabaseURL = "TEST_DATABASE_URL"
+
+// New создает схему test_<uuid>, применяет к ней миграции из migrations/*.up.sql и возвращает пул,
+// у соединений которого search_path указывает на эту схему. После теста схема удаляется,
+// поэтому тесты не мешают друг другу и могут идти параллельно на одной базе.
+func New(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+
+	databaseURL := os.Getenv(EnvDatabaseURL)
+	if databaseURL == "" {
+		t.Skipf("%s is not set, skipping integration test", EnvDatabaseURL)
+	}
+	ctx := context.Background()
+
+	admin, err := pgx.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatalf("connect to test database: %v", err)
+	}
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		admin.Close(ctx)
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("drop schema %s: %v", schema, err)
+		}
+		admin.Close(ctx)
+	})
+
+	cfg, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		t.Fatalf("parse %s: %v", EnvDatabaseURL, err)
+	}
+	// public остается в пути ради расширений (pg_trgm), установленных в базе заранее
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema + ",public"
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("create pool: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrate(t, ctx, pool)
+	return pool
+}
+
+// migrate применяет up-миграции по порядку номеров, как golang-migrate: каждый файл одним запросом.
+func migrate(t testing.TB, ctx context.Context, pool *pgxpool.Pool) {
+	t.Helper()
+
+	_, file, _, _ := runtime.Caller(0)
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "migrations", "*.up.sql"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("find migrations: %v", err)
+	}
+	slices.Sort(files)
+
+	for _, path := range files {
+		sql, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read migration: %v", err)
+		}
+		if _, err := pool.Exec(ctx, string(sql)); err != nil {
+			t.Fatalf("apply migration %s: %v", filepath.Base(path), err)
+		}
+	}
+}
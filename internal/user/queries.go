@@ -5,11 +5,19 @@ var (
 		INSERT INTO users (user_id, username, team_name, is_active)
 		VALUES ($1, $2, $3, $4)
 		ON CONFLICT (user_id) DO UPDATE
-		SET username = EXCLUDED.username, team_name = EXCLUDED.team_name, is_active = EXCLUDED.is_active;
+		SET username = EXCLUDED.username, team_name = COALESCE(users.team_name, EXCLUDED.team_name), is_active = EXCLUDED.is_active;
+	`
+
+	addMembershipQuery = `
+		INSERT INTO team_memberships (user_id, team_name) VALUES ($1, $2) ON CONFLICT DO NOTHING;
+	`
+
+	setMissingPrimaryTeamQuery = `
+		UPDATE users SET team_name = $1 WHERE user_id = $2 AND team_name IS NULL;
 	`
 
 	getBydIdUserQuery = `
-		SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1;
+		SELECT user_id, username, COALESCE(team_name, ''), is_active FROM users WHERE user_id = $1;
 	`
 
 	setIsActiveUserQuery = `
@@ -17,21 +25,93 @@ var (
 	`
 
 	getActiveUsersByTeamQueryWithLimit = `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE team_name = $1 AND is_active = TRUE AND user_id != $2
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active
+		FROM users u
+		JOIN team_memberships m ON m.user_id = u.user_id
+		WHERE m.team_name = $1 AND u.is_active = TRUE AND u.user_id != $2
 		ORDER BY RANDOM()
 		LIMIT $3;	
 	`
 
 	getActiveUsersByTeamQueryNoLimit = `
-		SELECT user_id, username, team_name, is_active
-		FROM users
-		WHERE team_name = $1 AND is_active = TRUE AND user_id != $2
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active
+		FROM users u
+		JOIN team_memberships m ON m.user_id = u.user_id
+		WHERE m.team_name = $1 AND u.is_active = TRUE AND u.user_id != $2
 		ORDER BY RANDOM();	
 	`
 
-	getTeamByUserIdQuery = `
-		SELECT team_name FROM users WHERE user_id = $1;	
+	removePrimaryMembershipQuery = `
+		DELETE FROM team_memberships m
+		USING users u
+		WHERE u.user_id = $1 AND m.user_id = u.user_id AND m.team_name = u.team_name;
+	`
+
+	setPrimaryTeamQuery = `
+		UPDATE users SET team_name = $1 WHERE user_id = $2;
+	`
+
+	removeMembershipQuery = `
+		DELETE FROM team_memberships WHERE user_id = $1 AND team_name = $2;
+	`
+
+	// Если пользователь покинул основную команду, основной становится любая из оставшихся.
+	reassignPrimaryTeamQuery = `
+		UPDATE users u
+		SET team_name = (
+			SELECT m.team_name FROM team_memberships m WHERE m.user_id = u.user_id ORDER BY m.team_name LIMIT 1
+		)
+		WHERE u.user_id = $1 AND u.team_name = $2;
 	`
+
+	// LIMIT NULL в PostgreSQL означает отсутствие ограничения.
+	getLeastLoadedActiveUsersByTeamQuery = `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active
+		FROM users u
+		JOIN team_memberships m ON m.user_id = u.user_id
+		LEFT JOIN (
+			SELECT rev.user_id, COUNT(*) AS open_reviews
+			FROM reviewers rev
+			JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id
+			WHERE pr.status = 'OPEN'
+			GROUP BY rev.user_id
+		) load ON load.user_id = u.user_id
+		WHERE m.team_name = $1 AND u.is_active = TRUE AND u.user_id != $2
+		ORDER BY COALESCE(load.open_reviews, 0), RANDOM()
+		LIMIT $3;
+	`
+
+	// Основная команда идет первой, остальные — по алфавиту.
+	getTeamsByUserIdQuery = `
+		SELECT m.team_name
+		FROM team_memberships m
+		JOIN users u ON u.user_id = m.user_id
+		WHERE m.user_id = $1
+		ORDER BY m.team_name IS DISTINCT FROM u.team_name, m.team_name;
+	`
+
+	getUserProfileQuery = `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, u.role,
+		       ARRAY(SELECT m.team_name FROM team_memberships m WHERE m.user_id = u.user_id ORDER BY m.team_name)
+		FROM users u
+		WHERE u.user_id = $1;
+	`
+
+	// Условия WHERE собираются в List только из заданных фильтров: с catch-all условиями вида
+	// ($1 IS NULL OR ...) общий план Postgres не использует индексы по role, is_active и триграммам.
+	// Пагинация — по user_id (keyset), поэтому страницы стабильны при вставках.
+	listUsersQuery = `
+		SELECT u.user_id, u.username, COALESCE(u.team_name, ''), u.is_active, u.role,
+		       ARRAY(SELECT m.team_name FROM team_memberships m WHERE m.user_id = u.user_id ORDER BY m.team_name)
+		FROM users u
+	`
+
+	// Фрагменты условий listUsersQuery; %d — номер параметра.
+	listUsersTeamFilter    = `EXISTS (SELECT 1 FROM team_memberships m WHERE m.user_id = u.user_id AND m.team_name = $%d)`
+	listUsersActiveFilter  = `u.is_active = $%d`
+	listUsersRoleFilter    = `u.role = $%d`
+	listUsersPrefixFilter  = `lower(u.username) LIKE $%d`
+	listUsersTrigramFilter = `u.username %% $%d`
+	listUsersAfterFilter   = `u.user_id > $%d`
+	listUsersOrderAndLimit = `ORDER BY u.user_id LIMIT $%d`
 )
@@ -6,6 +6,7 @@ import (
 	"deplagene/avito-tech-internship/types"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -20,7 +21,8 @@ func NewUserRepository(db *pgxpool.Pool) *UserRepository {
 	return &UserRepository{db: db}
 }
 
-// Upsert создает или обновляет пользователя.
+// Upsert создает или обновляет пользователя и добавляет его в команду.
+// Основную команду существующего пользователя Upsert не меняет: переезд выполняется только через SetTeam.
 func (r *UserRepository) Upsert(ctx context.Context, tx pgx.Tx, user api.TeamMember, teamName string) error {
 	const op = "user.repository.Upsert"
 
@@ -29,6 +31,24 @@ func (r *UserRepository) Upsert(ctx context.Context, tx pgx.Tx, user api.TeamMem
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := r.AddMembership(ctx, tx, user.UserId, teamName); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// AddMembership добавляет пользователя в команду, не затрагивая остальные его команды.
+// Если у пользователя еще нет основной команды, ею становится teamName.
+func (r *UserRepository) AddMembership(ctx context.Context, tx pgx.Tx, userID, teamName string) error {
+	const op = "user.repository.AddMembership"
+
+	if _, err := tx.Exec(ctx, addMembershipQuery, userID, teamName); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.Exec(ctx, setMissingPrimaryTeamQuery, teamName, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
 
@@ -97,17 +117,187 @@ func (r *UserRepository) GetActiveUsersByTeam(ctx context.Context, tx pgx.Tx, te
 	return users, nil
 }
 
-// GetTeamByUserID возвращает имя команды, к которой принадлежит пользователь.
-func (r *UserRepository) GetTeamByUserID(ctx context.Context, tx pgx.Tx, userID string) (string, error) {
-	const op = "user.repository.GetTeamByUserID"
+// GetLeastLoadedActiveUsersByTeam возвращает активных пользователей из команды, исключая указанного,
+// по возрастанию числа открытых ревью. Равные по нагрузке перемешиваются случайно.
+func (r *UserRepository) GetLeastLoadedActiveUsersByTeam(ctx context.Context, tx pgx.Tx, teamName string, excludeUserID string, limit int) ([]api.User, error) {
+	const op = "user.repository.GetLeastLoadedActiveUsersByTeam"
 
-	var teamName string
+	var limitArg *int
+	if limit > 0 {
+		limitArg = &limit
+	}
 
-	err := tx.QueryRow(ctx, getTeamByUserIdQuery, userID).Scan(&teamName)
+	rows, err := tx.Query(ctx, getLeastLoadedActiveUsersByTeamQuery, teamName, excludeUserID, limitArg)
 	if err != nil {
-		return "", fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var users []api.User
+	for rows.Next() {
+		var user api.User
+		if err := rows.Scan(&user.UserId, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return teamName, nil
+	return users, nil
+}
+
+// GetTeamsByUserID возвращает все команды пользователя. Основная команда идет первой.
+func (r *UserRepository) GetTeamsByUserID(ctx context.Context, tx pgx.Tx, userID string) ([]string, error) {
+	const op = "user.repository.GetTeamsByUserID"
+
+	rows, err := tx.Query(ctx, getTeamsByUserIdQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var teamName string
+		if err := rows.Scan(&teamName); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		teams = append(teams, teamName)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return teams, nil
+}
+
+// SetTeam переводит пользователя из основной команды в другую.
+// Членство в остальных командах сохраняется.
+func (r *UserRepository) SetTeam(ctx context.Context, tx pgx.Tx, userID, teamName string) error {
+	const op = "user.repository.SetTeam"
+
+	if _, err := tx.Exec(ctx, removePrimaryMembershipQuery, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.Exec(ctx, addMembershipQuery, userID, teamName); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.Exec(ctx, setPrimaryTeamQuery, teamName, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RemoveFromTeam исключает пользователя из команды. Если это была основная команда,
+// основной становится одна из оставшихся. Возвращает false, если пользователь не состоял в команде.
+func (r *UserRepository) RemoveFromTeam(ctx context.Context, tx pgx.Tx, userID, teamName string) (bool, error) {
+	const op = "user.repository.RemoveFromTeam"
+
+	tag, err := tx.Exec(ctx, removeMembershipQuery, userID, teamName)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, reassignPrimaryTeamQuery, userID, teamName); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return true, nil
+}
+
+// GetProfile возвращает карточку пользователя со всеми его командами и ролью.
+func (r *UserRepository) GetProfile(ctx context.Context, tx pgx.Tx, id string) (*api.UserProfile, error) {
+	const op = "user.repository.GetProfile"
+
+	profile, err := scanProfile(tx.QueryRow(ctx, getUserProfileQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return profile, nil
+}
+
+// List возвращает не более limit пользователей с user_id больше afterID, подходящих под фильтры.
+func (r *UserRepository) List(ctx context.Context, tx pgx.Tx, params api.GetUsersListParams, afterID string, limit int) ([]api.UserProfile, error) {
+	const op = "user.repository.List"
+
+	query, args := buildListUsersQuery(params, afterID, limit)
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var profiles []api.UserProfile
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		profiles = append(profiles, *profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return profiles, nil
+}
+
+// buildListUsersQuery собирает запрос List из заданных фильтров. Префиксный и триграммный поиск
+// дают разные условия, чтобы планировщик выбирал подходящий индекс.
+func buildListUsersQuery(params api.GetUsersListParams, afterID string, limit int) (string, []any) {
+	var conditions []string
+	var args []any
+	add := func(filter string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(filter, len(args)))
+	}
+
+	if params.TeamName != nil {
+		add(listUsersTeamFilter, *params.TeamName)
+	}
+	if params.IsActive != nil {
+		add(listUsersActiveFilter, *params.IsActive)
+	}
+	if params.Role != nil {
+		add(listUsersRoleFilter, *params.Role)
+	}
+	if params.Query != "" {
+		switch params.Search {
+		case api.UserSearchModeTRIGRAM:
+			add(listUsersTrigramFilter, params.Query)
+		default:
+			add(listUsersPrefixFilter, likePrefix(strings.ToLower(params.Query)))
+		}
+	}
+	if afterID != "" {
+		add(listUsersAfterFilter, afterID)
+	}
+
+	query := listUsersQuery
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+	args = append(args, limit)
+	query += fmt.Sprintf(listUsersOrderAndLimit, len(args))
+	return query, args
+}
+
+func scanProfile(row pgx.Row) (*api.UserProfile, error) {
+	profile := &api.UserProfile{}
+	if err := row.Scan(&profile.UserId, &profile.Username, &profile.TeamName, &profile.IsActive, &profile.Role, &profile.Teams); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// likePrefix экранирует спецсимволы LIKE и превращает строку в шаблон поиска по префиксу.
+func likePrefix(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return replacer.Replace(s) + "%"
 }
 
 // Проверка соответствия интерфейсу во время компиляции
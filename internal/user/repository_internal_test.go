@@ -0,0 +1,68 @@
+package user
+
+import (
+	"deplagene/avito-tech-internship/cmd/api"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBuildListUsersQuery(t *testing.T) {
+	tests := []struct {
+		name      string
+		params    api.GetUsersListParams
+		afterID   string
+		wantWhere []string
+		wantArgs  []any
+	}{
+		{
+			name:     "no filters",
+			wantArgs: []any{20},
+		},
+		{
+			name:      "team, active and role",
+			params:    api.GetUsersListParams{TeamName: api.Ptr("backend"), IsActive: api.Ptr(true), Role: api.Ptr("admin")},
+			wantWhere: []string{"m.team_name = $1", "u.is_active = $2", "u.role = $3"},
+			wantArgs:  []any{"backend", true, "admin", 20},
+		},
+		{
+			name:      "prefix search",
+			params:    api.GetUsersListParams{Query: "Al_"},
+			wantWhere: []string{"lower(u.username) LIKE $1"},
+			wantArgs:  []any{`al\_%`, 20},
+		},
+		{
+			name:      "trigram search after cursor",
+			params:    api.GetUsersListParams{Query: "alice", Search: api.UserSearchModeTRIGRAM},
+			afterID:   "u5",
+			wantWhere: []string{"u.username % $1", "u.user_id > $2"},
+			wantArgs:  []any{"alice", "u5", 20},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := buildListUsersQuery(tt.params, tt.afterID, 20)
+
+			// Один WHERE всегда есть в подзапросе команд пользователя
+			if got := strings.Count(query, "WHERE") > 1; got != (len(tt.wantWhere) > 0) {
+				t.Errorf("query has WHERE = %v, want %v:\n%s", got, len(tt.wantWhere) > 0, query)
+			}
+			if strings.Contains(query, "IS NULL") {
+				t.Errorf("query contains catch-all condition:\n%s", query)
+			}
+			for _, cond := range tt.wantWhere {
+				if !strings.Contains(query, cond) {
+					t.Errorf("query lacks %q:\n%s", cond, query)
+				}
+			}
+			wantLimit := fmt.Sprintf("LIMIT $%d", len(tt.wantArgs))
+			if !strings.HasSuffix(strings.TrimSpace(query), wantLimit) {
+				t.Errorf("query does not end with %q:\n%s", wantLimit, query)
+			}
+			if !slices.Equal(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -3,30 +3,51 @@ package user
 import (
 	"context"
 	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/internal/metrics"
 	"deplagene/avito-tech-internship/types"
 	"deplagene/avito-tech-internship/utils"
+	"encoding/base64"
 	"fmt"
 	"log/slog"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Service struct {
-	userRepo types.UserRepository
-	db       *pgxpool.Pool
-	logger   *slog.Logger
+	userRepo   types.UserRepository
+	teamRepo   types.TeamRepository
+	reassigner types.ReviewReassigner
+	events     types.EventRecorder
+	db         *pgxpool.Pool
+	logger     *slog.Logger
 }
 
-func NewService(userRepo types.UserRepository, db *pgxpool.Pool, logger *slog.Logger) *Service {
+func NewService(
+	userRepo types.UserRepository,
+	teamRepo types.TeamRepository,
+	reassigner types.ReviewReassigner,
+	events types.EventRecorder,
+	db *pgxpool.Pool,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
-		userRepo: userRepo,
-		db:       db,
-		logger:   logger,
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		reassigner: reassigner,
+		events:     events,
+		db:         db,
+		logger:     logger,
 	}
 }
 
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
 // SetUserIsActive устанавливает флаг активности пользователя.
-func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive bool) (*api.User, error) {
+func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive bool) (updatedUser *api.User, err error) {
 	const op = "user.service.SetUserIsActive"
 
 	tx, err := s.db.Begin(ctx)
@@ -36,18 +57,25 @@ func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive b
 	defer func() {
 		if r := recover(); r != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction after panic", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
 			}
 			panic(r)
 		} else if err != nil {
 			if err := tx.Rollback(ctx); err != nil {
-				s.logger.Error("failed to rollback transaction", utils.Err(err))
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
 			}
 		} else {
 			err = tx.Commit(ctx)
 		}
 	}()
 
+	return s.SetUserIsActiveTx(ctx, tx, userID, isActive)
+}
+
+// SetUserIsActiveTx выполняет SetUserIsActive в рамках внешней транзакции.
+func (s *Service) SetUserIsActiveTx(ctx context.Context, tx pgx.Tx, userID string, isActive bool) (*api.User, error) {
+	const op = "user.service.SetUserIsActiveTx"
+
 	existingUser, err := s.userRepo.GetByID(ctx, tx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -60,9 +88,210 @@ func (s *Service) SetUserIsActive(ctx context.Context, userID string, isActive b
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if existingUser.IsActive != isActive {
+		eventType := api.DomainEventUSERDEACTIVATED
+		if isActive {
+			eventType = api.DomainEventUSERACTIVATED
+		}
+		event := api.DomainEvent{Type: eventType, UserId: userID, TeamName: existingUser.TeamName}
+		if err := s.events.Record(ctx, tx, event); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	existingUser.IsActive = isActive
 	return existingUser, nil
 }
 
+// MoveUser явно переводит пользователя в другую команду.
+// Открытые ревью пользователя переназначаются на участников его прежней команды.
+func (s *Service) MoveUser(ctx context.Context, userID, teamName string) (movedUser *api.User, err error) {
+	const op = "user.service.MoveUser"
+
+	ctx, flushMetrics := metrics.WithAfterCommit(ctx)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err == nil {
+			flushMetrics()
+		}
+	}()
+
+	return s.MoveUserTx(ctx, tx, userID, teamName)
+}
+
+// MoveUserTx выполняет MoveUser в рамках внешней транзакции.
+func (s *Service) MoveUserTx(ctx context.Context, tx pgx.Tx, userID, teamName string) (*api.User, error) {
+	const op = "user.service.MoveUserTx"
+
+	movedUser, err := s.userRepo.GetByID(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if movedUser == nil {
+		return nil, types.ErrNotFound
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, tx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, types.ErrNotFound
+	}
+
+	oldTeam := movedUser.TeamName
+	if oldTeam == teamName {
+		return movedUser, nil
+	}
+
+	if err = s.userRepo.SetTeam(ctx, tx, userID, teamName); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	event := api.DomainEvent{
+		Type:             api.DomainEventUSERMOVED,
+		UserId:           userID,
+		TeamName:         teamName,
+		PreviousTeamName: oldTeam,
+	}
+	if err = s.events.Record(ctx, tx, event); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if oldTeam != "" {
+		if err = s.reassigner.ReassignOpenReviews(ctx, tx, userID, oldTeam); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	movedUser.TeamName = teamName
+	return movedUser, nil
+}
+
+// GetUser возвращает карточку пользователя.
+func (s *Service) GetUser(ctx context.Context, userID string) (profile *api.UserProfile, err error) {
+	const op = "user.service.GetUser"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	profile, err = s.userRepo.GetProfile(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if profile == nil {
+		return nil, types.ErrNotFound
+	}
+	return profile, nil
+}
+
+// ListUsers возвращает страницу справочника пользователей с фильтрами и поиском по username.
+func (s *Service) ListUsers(ctx context.Context, params api.GetUsersListParams) (page *api.UserPage, err error) {
+	const op = "user.service.ListUsers"
+
+	switch {
+	case params.Limit == 0:
+		params.Limit = defaultListLimit
+	case params.Limit < 0 || params.Limit > maxListLimit:
+		return nil, fmt.Errorf("%s: limit must be between 1 and %d: %w", op, maxListLimit, types.ErrInvalidInput)
+	}
+
+	switch params.Search {
+	case "":
+		params.Search = api.UserSearchModePREFIX
+	case api.UserSearchModePREFIX, api.UserSearchModeTRIGRAM:
+	default:
+		return nil, fmt.Errorf("%s: unknown search mode %q: %w", op, params.Search, types.ErrInvalidInput)
+	}
+
+	afterID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+	profiles, err := s.userRepo.List(ctx, tx, params, afterID, params.Limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	page = &api.UserPage{Users: profiles}
+	if len(profiles) > params.Limit {
+		page.Users = profiles[:params.Limit]
+		page.NextCursor = api.Ptr(encodeCursor(page.Users[params.Limit-1].UserId))
+	}
+	if page.Users == nil {
+		page.Users = []api.UserProfile{}
+	}
+	return page, nil
+}
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+func encodeCursor(userID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(userID))
+}
+
+func decodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	userID, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor: %w", types.ErrInvalidInput)
+	}
+	return string(userID), nil
+}
+
 // Проверка соответствия интерфейсу во время компиляции
 var _ types.UserService = (*Service)(nil)
+var _ types.UserTxService = (*Service)(nil)
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Заголовки доставки GitHub.
+const (
+	githubEventHeader     = "X-GitHub-Event"
+	githubSignatureHeader = "X-Hub-Signature-256"
+)
+
+// githubPullRequestEvent — нужная сервису часть payload события pull_request.
+type githubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Draft  bool   `json:"draft"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// verifyGitHubSignature проверяет заголовок X-Hub-Signature-256: HMAC-SHA256 тела запроса
+// с секретом вебхука в виде "sha256=<hex>".
+func verifyGitHubSignature(secret string, body []byte, header string) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// parseGitHubPullRequest сводит событие pull_request к действию над PR.
+// ID PR в сервисе — "<owner>/<repo>#<number>".
+func parseGitHubPullRequest(body []byte) (prEvent, error) {
+	var payload githubPullRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return prEvent{}, fmt.Errorf("decode pull_request payload: %w: %w", err, types.ErrInvalidInput)
+	}
+	if payload.Repository.FullName == "" || payload.Number == 0 {
+		return prEvent{}, fmt.Errorf("pull_request payload has no repository or number: %w", types.ErrInvalidInput)
+	}
+
+	event := prEvent{
+		Provider:      ProviderGitHub,
+		Event:         "pull_request",
+		RawAction:     payload.Action,
+		PullRequestID: fmt.Sprintf("%s#%d", payload.Repository.FullName, payload.Number),
+		Title:         payload.PullRequest.Title,
+		AuthorLogin:   payload.PullRequest.User.Login,
+		Draft:         payload.PullRequest.Draft,
+	}
+
+	switch payload.Action {
+	case "opened":
+		event.Action = actionOpen
+	case "reopened":
+		event.Action = actionReopen
+	case "closed":
+		event.Action = actionClose
+		if payload.PullRequest.Merged {
+			event.Action = actionMerge
+		}
+	case "converted_to_draft":
+		event.Action = actionDraft
+	case "ready_for_review":
+		event.Action = actionReady
+	default:
+		event.Action = actionIgnore
+	}
+	return event, nil
+}
+
+// HandleGitHub обрабатывает доставку вебхука GitHub с уже проверенной подписью.
+// Кроме pull_request понимает ping, остальные события игнорируются.
+func (s *Service) HandleGitHub(ctx context.Context, eventType string, body []byte) (*api.WebhookResult, error) {
+	const op = "webhook.service.HandleGitHub"
+
+	switch eventType {
+	case "ping":
+		return &api.WebhookResult{Event: eventType, Result: "pong"}, nil
+	case "pull_request":
+	default:
+		return &api.WebhookResult{Event: eventType, Result: resultIgnored}, nil
+	}
+
+	event, err := parseGitHubPullRequest(body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return s.handleEvent(ctx, event)
+}
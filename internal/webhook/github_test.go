@@ -0,0 +1,259 @@
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/events"
+	"deplagene/avito-tech-internship/internal/pullrequest"
+	"deplagene/avito-tech-internship/internal/team"
+	"deplagene/avito-tech-internship/internal/testdb"
+	"deplagene/avito-tech-internship/internal/user"
+	"deplagene/avito-tech-internship/internal/webhook"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	testSecret = "github-secret"
+	testToken  = "gitlab-token"
+
+	githubPullRequestID = "avito-tech/review-service#42"
+)
+
+var testConfig = webhook.Config{GitHubSecret: testSecret, GitLabToken: testToken}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+// newTestService поднимает сервис вебхуков на базе из testdb. Автор octo состоит в командах
+// backend (основная) и frontend, поэтому PR без указания команды для него требует TEAM_REQUIRED.
+func newTestService(t *testing.T) (*webhook.Service, *pgxpool.Pool) {
+	t.Helper()
+
+	pool := testdb.New(t)
+	ctx := context.Background()
+	logger := discardLogger()
+
+	teamRepo := team.NewTeamRepository(pool)
+	userRepo := user.NewUserRepository(pool)
+	recorder := events.NewRecorder()
+	prService := pullrequest.NewService(pullrequest.NewPullRequestRepository(pool), userRepo, teamRepo, recorder, pool, logger)
+	teamService := team.NewService(teamRepo, userRepo, prService, recorder, pool, logger)
+
+	for _, tm := range []api.Team{
+		{TeamName: "backend", Members: []api.TeamMember{
+			{UserId: "octo", Username: "octo", IsActive: true},
+			{UserId: "u1", Username: "u1", IsActive: true},
+			{UserId: "u2", Username: "u2", IsActive: true},
+		}},
+		{TeamName: "frontend", Members: []api.TeamMember{
+			{UserId: "f1", Username: "f1", IsActive: true},
+		}},
+	} {
+		if _, err := teamService.CreateTeam(ctx, tm); err != nil {
+			t.Fatalf("create team %s: %v", tm.TeamName, err)
+		}
+	}
+	if _, err := teamService.AddMember(ctx, "frontend", "octo"); err != nil {
+		t.Fatalf("add octo to frontend: %v", err)
+	}
+
+	return webhook.NewService(webhook.NewRepository(pool), userRepo, prService, pool, logger), pool
+}
+
+func linkIdentity(t *testing.T, service *webhook.Service, provider, login string) {
+	t.Helper()
+
+	identity := api.GitIdentity{Provider: provider, Login: login, UserId: "octo"}
+	if _, err := service.LinkIdentity(context.Background(), identity); err != nil {
+		t.Fatalf("link %s identity: %v", provider, err)
+	}
+}
+
+func readFixture(t *testing.T, provider, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", provider, name+".json"))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return body
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliverGitHub(t *testing.T, router http.Handler, event string, body []byte, signature string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/github", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	if signature != "" {
+		req.Header.Set("X-Hub-Signature-256", signature)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeResult(t *testing.T, rec *httptest.ResponseRecorder) api.WebhookResult {
+	t.Helper()
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d, want 200: %s", rec.Code, rec.Body)
+	}
+	var result api.WebhookResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("decode result: %v", err)
+	}
+	return result
+}
+
+func assertErrorCode(t *testing.T, rec *httptest.ResponseRecorder, status int, code api.ErrorResponseErrorCode) {
+	t.Helper()
+
+	if rec.Code != status {
+		t.Fatalf("status %d, want %d: %s", rec.Code, status, rec.Body)
+	}
+	var resp api.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if resp.Error.Code != code {
+		t.Errorf("error code %s, want %s", resp.Error.Code, code)
+	}
+}
+
+// Подпись проверяется до обращения к сервису, поэтому он здесь не нужен.
+func TestGitHubRejectsBadSignature(t *testing.T) {
+	router := webhook.NewHandler(nil, testConfig, discardLogger()).Routes()
+	body := readFixture(t, "github", "pull_request.opened")
+
+	tests := []struct {
+		name      string
+		body      []byte
+		signature string
+	}{
+		{name: "missing", body: body},
+		{name: "wrong secret", body: body, signature: sign("other-secret", body)},
+		{name: "sha1 prefix", body: body, signature: "sha1=" + sign(testSecret, body)[len("sha256="):]},
+		{name: "not hex", body: body, signature: "sha256=zz"},
+		{name: "tampered body", body: append(bytes.Clone(body), ' '), signature: sign(testSecret, body)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := deliverGitHub(t, router, "pull_request", tt.body, tt.signature)
+			assertErrorCode(t, rec, http.StatusUnauthorized, api.UNAUTHORIZED)
+		})
+	}
+}
+
+func TestGitHubPing(t *testing.T) {
+	router := webhook.NewHandler(nil, testConfig, discardLogger()).Routes()
+	body := readFixture(t, "github", "ping")
+
+	result := decodeResult(t, deliverGitHub(t, router, "ping", body, sign(testSecret, body)))
+	if result.Result != "pong" {
+		t.Errorf("result %q, want pong", result.Result)
+	}
+}
+
+// TestGitHubReplay проигрывает записанные доставки в порядке жизненного цикла PR, включая повторные.
+func TestGitHubReplay(t *testing.T) {
+	service, pool := newTestService(t)
+	router := webhook.NewHandler(service, testConfig, discardLogger()).Routes()
+
+	opened := readFixture(t, "github", "pull_request.opened")
+	rec := deliverGitHub(t, router, "pull_request", opened, sign(testSecret, opened))
+	assertErrorCode(t, rec, http.StatusUnprocessableEntity, api.UNKNOWNIDENTITY)
+
+	linkIdentity(t, service, webhook.ProviderGitHub, "Octo-Dev")
+
+	steps := []struct {
+		fixture string
+		want    string
+	}{
+		{fixture: "pull_request.opened", want: "created"},
+		{fixture: "pull_request.opened", want: "duplicate"},
+		{fixture: "pull_request.synchronize", want: "ignored"},
+		{fixture: "pull_request.converted_to_draft", want: "draft"},
+		{fixture: "pull_request.ready_for_review", want: "ready"},
+		{fixture: "pull_request.closed", want: "closed"},
+		{fixture: "pull_request.reopened", want: "reopened"},
+		{fixture: "pull_request.closed_merged", want: "merged"},
+		{fixture: "pull_request.closed_merged", want: "merged"},
+	}
+	for _, step := range steps {
+		body := readFixture(t, "github", step.fixture)
+		result := decodeResult(t, deliverGitHub(t, router, "pull_request", body, sign(testSecret, body)))
+		if result.Result != step.want {
+			t.Fatalf("%s: result %q, want %q", step.fixture, result.Result, step.want)
+		}
+		if result.PullRequestId != githubPullRequestID {
+			t.Errorf("%s: pull_request_id %q, want %q", step.fixture, result.PullRequestId, githubPullRequestID)
+		}
+	}
+
+	assertPullRequest(t, pool, githubPullRequestID, "backend", api.PullRequestStatusMERGED)
+}
+
+func TestGitHubReplayDraft(t *testing.T) {
+	service, pool := newTestService(t)
+	router := webhook.NewHandler(service, testConfig, discardLogger()).Routes()
+	linkIdentity(t, service, webhook.ProviderGitHub, "octo-dev")
+
+	steps := []struct {
+		fixture string
+		want    string
+	}{
+		{fixture: "pull_request.opened_draft", want: "created_draft"},
+		{fixture: "pull_request.opened_draft", want: "duplicate"},
+		{fixture: "pull_request.ready_for_review", want: "ready"},
+		{fixture: "pull_request.closed", want: "closed"},
+	}
+	for _, step := range steps {
+		body := readFixture(t, "github", step.fixture)
+		result := decodeResult(t, deliverGitHub(t, router, "pull_request", body, sign(testSecret, body)))
+		if result.Result != step.want {
+			t.Fatalf("%s: result %q, want %q", step.fixture, result.Result, step.want)
+		}
+	}
+
+	assertPullRequest(t, pool, githubPullRequestID, "backend", api.PullRequestStatusCLOSED)
+}
+
+// assertPullRequest проверяет команду и статус PR напрямую в базе: PR из вебхука создается
+// в основной команде автора.
+func assertPullRequest(t *testing.T, pool *pgxpool.Pool, prID, wantTeam string, wantStatus api.PullRequestStatus) {
+	t.Helper()
+
+	var teamName, status string
+	err := pool.QueryRow(context.Background(),
+		`SELECT team_name, status::text FROM pull_requests WHERE pull_request_id = $1`, prID).Scan(&teamName, &status)
+	if err != nil {
+		t.Fatalf("query pull request: %v", err)
+	}
+	if teamName != wantTeam {
+		t.Errorf("team %q, want %q", teamName, wantTeam)
+	}
+	if status != string(wantStatus) {
+		t.Errorf("status %q, want %q", status, wantStatus)
+	}
+}
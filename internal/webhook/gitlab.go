@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/types"
+	"encoding/json"
+	"fmt"
+)
+
+// Заголовки доставки GitLab.
+const (
+	gitlabEventHeader = "X-Gitlab-Event"
+	gitlabTokenHeader = "X-Gitlab-Token"
+
+	gitlabMergeRequestHook = "Merge Request Hook"
+)
+
+// gitlabBoolChange — изменение логического атрибута MR в блоке changes.
+type gitlabBoolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+// gitlabMergeRequestEvent — нужная сервису часть payload Merge Request Hook.
+type gitlabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID            int    `json:"iid"`
+		Title          string `json:"title"`
+		Action         string `json:"action"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+	} `json:"object_attributes"`
+	Changes struct {
+		Draft          *gitlabBoolChange `json:"draft"`
+		WorkInProgress *gitlabBoolChange `json:"work_in_progress"`
+	} `json:"changes"`
+}
+
+// parseGitLabMergeRequest сводит Merge Request Hook к действию над PR.
+// ID PR в сервисе — "<group>/<project>!<iid>". В payload нет логина автора MR, поэтому
+// автором считается пользователь, выполнивший действие: для open это всегда автор.
+func parseGitLabMergeRequest(body []byte) (prEvent, error) {
+	var payload gitlabMergeRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return prEvent{}, fmt.Errorf("decode merge request payload: %w: %w", err, types.ErrInvalidInput)
+	}
+	if payload.ObjectKind != "merge_request" {
+		return prEvent{}, fmt.Errorf("unexpected object_kind %q: %w", payload.ObjectKind, types.ErrInvalidInput)
+	}
+	if payload.Project.PathWithNamespace == "" || payload.ObjectAttributes.IID == 0 {
+		return prEvent{}, fmt.Errorf("merge request payload has no project or iid: %w", types.ErrInvalidInput)
+	}
+
+	attrs := payload.ObjectAttributes
+	event := prEvent{
+		Provider:      ProviderGitLab,
+		Event:         "merge_request",
+		RawAction:     attrs.Action,
+		PullRequestID: fmt.Sprintf("%s!%d", payload.Project.PathWithNamespace, attrs.IID),
+		Title:         attrs.Title,
+		AuthorLogin:   payload.User.Username,
+		// work_in_progress — прежнее название draft в старых версиях GitLab
+		Draft: attrs.Draft || attrs.WorkInProgress,
+	}
+
+	switch attrs.Action {
+	case "open":
+		event.Action = actionOpen
+	case "reopen":
+		event.Action = actionReopen
+	case "close":
+		event.Action = actionClose
+	case "merge":
+		event.Action = actionMerge
+	case "update":
+		// Перевод в черновик и обратно приходит как update с изменением draft
+		change := payload.Changes.Draft
+		if change == nil {
+			change = payload.Changes.WorkInProgress
+		}
+		switch {
+		case change == nil || change.Previous == change.Current:
+			event.Action = actionIgnore
+		case change.Current:
+			event.Action = actionDraft
+		default:
+			event.Action = actionReady
+		}
+	default:
+		event.Action = actionIgnore
+	}
+	return event, nil
+}
+
+// HandleGitLab обрабатывает доставку вебхука GitLab с уже проверенным токеном.
+// Применяются только Merge Request Hook, остальные события игнорируются.
+func (s *Service) HandleGitLab(ctx context.Context, eventType string, body []byte) (*api.WebhookResult, error) {
+	const op = "webhook.service.HandleGitLab"
+
+	if eventType != gitlabMergeRequestHook {
+		return &api.WebhookResult{Event: eventType, Result: resultIgnored}, nil
+	}
+
+	event, err := parseGitLabMergeRequest(body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return s.handleEvent(ctx, event)
+}
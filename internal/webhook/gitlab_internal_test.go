@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseGitLabMergeRequest проверяет, к какому действию над PR сводится каждый записанный payload.
+// В отличие от проигрывания через сервис, тест не требует базы.
+func TestParseGitLabMergeRequest(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  lifecycleAction
+		draft   bool
+		author  string
+	}{
+		{fixture: "merge_request.open", action: actionOpen, author: "octo.dev"},
+		{fixture: "merge_request.open_draft", action: actionOpen, draft: true, author: "octo.dev"},
+		{fixture: "merge_request.update_description", action: actionIgnore, author: "octo.dev"},
+		{fixture: "merge_request.update_draft", action: actionDraft, draft: true, author: "octo.dev"},
+		{fixture: "merge_request.update_ready", action: actionReady, author: "octo.dev"},
+		{fixture: "merge_request.close", action: actionClose, author: "octo.dev"},
+		{fixture: "merge_request.reopen", action: actionReopen, author: "octo.dev"},
+		{fixture: "merge_request.merge", action: actionMerge, author: "team.lead"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join("testdata", "gitlab", tt.fixture+".json"))
+			if err != nil {
+				t.Fatalf("read fixture: %v", err)
+			}
+
+			event, err := parseGitLabMergeRequest(body)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if event.Action != tt.action {
+				t.Errorf("action %q, want %q", event.Action, tt.action)
+			}
+			if event.Draft != tt.draft {
+				t.Errorf("draft %t, want %t", event.Draft, tt.draft)
+			}
+			if event.AuthorLogin != tt.author {
+				t.Errorf("author %q, want %q", event.AuthorLogin, tt.author)
+			}
+			if event.PullRequestID != "platform/review-service!17" {
+				t.Errorf("pull request id %q", event.PullRequestID)
+			}
+		})
+	}
+}
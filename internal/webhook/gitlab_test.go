@@ -0,0 +1,117 @@
+package webhook_test
+
+import (
+	"bytes"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/webhook"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const gitlabPullRequestID = "platform/review-service!17"
+
+func deliverGitLab(t *testing.T, router http.Handler, event string, body []byte, token string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/gitlab", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", event)
+	if token != "" {
+		req.Header.Set("X-Gitlab-Token", token)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+// Токен проверяется до обращения к сервису, поэтому он здесь не нужен.
+func TestGitLabRejectsBadToken(t *testing.T) {
+	router := webhook.NewHandler(nil, testConfig, discardLogger()).Routes()
+	body := readFixture(t, "gitlab", "merge_request.open")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing"},
+		{name: "wrong", token: "wrong-token"},
+		{name: "prefix", token: testToken[:len(testToken)-1]},
+		{name: "suffix", token: testToken + "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := deliverGitLab(t, router, "Merge Request Hook", body, tt.token)
+			assertErrorCode(t, rec, http.StatusUnauthorized, api.UNAUTHORIZED)
+		})
+	}
+}
+
+func TestGitLabIgnoresOtherEvents(t *testing.T) {
+	router := webhook.NewHandler(nil, testConfig, discardLogger()).Routes()
+	body := readFixture(t, "gitlab", "merge_request.open")
+
+	result := decodeResult(t, deliverGitLab(t, router, "Push Hook", body, testToken))
+	if result.Result != "ignored" {
+		t.Errorf("result %q, want ignored", result.Result)
+	}
+}
+
+// TestGitLabReplay проигрывает записанные Merge Request Hook в порядке жизненного цикла MR:
+// open, update с переключением черновика и без него, close, reopen и merge.
+func TestGitLabReplay(t *testing.T) {
+	service, pool := newTestService(t)
+	router := webhook.NewHandler(service, testConfig, discardLogger()).Routes()
+	linkIdentity(t, service, webhook.ProviderGitLab, "octo.dev")
+
+	steps := []struct {
+		fixture string
+		want    string
+	}{
+		{fixture: "merge_request.open", want: "created"},
+		{fixture: "merge_request.open", want: "duplicate"},
+		{fixture: "merge_request.update_description", want: "ignored"},
+		{fixture: "merge_request.update_draft", want: "draft"},
+		{fixture: "merge_request.update_ready", want: "ready"},
+		{fixture: "merge_request.close", want: "closed"},
+		{fixture: "merge_request.reopen", want: "reopened"},
+		{fixture: "merge_request.merge", want: "merged"},
+		{fixture: "merge_request.merge", want: "merged"},
+	}
+	for _, step := range steps {
+		body := readFixture(t, "gitlab", step.fixture)
+		result := decodeResult(t, deliverGitLab(t, router, "Merge Request Hook", body, testToken))
+		if result.Result != step.want {
+			t.Fatalf("%s: result %q, want %q", step.fixture, result.Result, step.want)
+		}
+		if result.PullRequestId != gitlabPullRequestID {
+			t.Errorf("%s: pull_request_id %q, want %q", step.fixture, result.PullRequestId, gitlabPullRequestID)
+		}
+	}
+
+	assertPullRequest(t, pool, gitlabPullRequestID, "backend", api.PullRequestStatusMERGED)
+}
+
+func TestGitLabReplayDraft(t *testing.T) {
+	service, pool := newTestService(t)
+	router := webhook.NewHandler(service, testConfig, discardLogger()).Routes()
+	linkIdentity(t, service, webhook.ProviderGitLab, "octo.dev")
+
+	steps := []struct {
+		fixture string
+		want    string
+	}{
+		{fixture: "merge_request.open_draft", want: "created_draft"},
+		{fixture: "merge_request.update_ready", want: "ready"},
+		{fixture: "merge_request.close", want: "closed"},
+	}
+	for _, step := range steps {
+		body := readFixture(t, "gitlab", step.fixture)
+		result := decodeResult(t, deliverGitLab(t, router, "Merge Request Hook", body, testToken))
+		if result.Result != step.want {
+			t.Fatalf("%s: result %q, want %q", step.fixture, result.Result, step.want)
+		}
+	}
+
+	assertPullRequest(t, pool, gitlabPullRequestID, "backend", api.PullRequestStatusCLOSED)
+}
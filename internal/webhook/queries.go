@@ -0,0 +1,24 @@
+package webhook
+
+var (
+	getIdentityUserIDQuery = `
+		SELECT user_id FROM git_identities WHERE provider = $1 AND login = $2;
+	`
+
+	upsertIdentityQuery = `
+		INSERT INTO git_identities (provider, login, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id;
+	`
+
+	deleteIdentityQuery = `
+		DELETE FROM git_identities WHERE provider = $1 AND login = $2;
+	`
+
+	listIdentitiesByUserQuery = `
+		SELECT provider, login, user_id
+		FROM git_identities
+		WHERE user_id = $1
+		ORDER BY provider, login;
+	`
+)
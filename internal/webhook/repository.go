@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// GetUserID возвращает user_id, с которым связан логин, или пустую строку, если связи нет.
+func (r *Repository) GetUserID(ctx context.Context, tx pgx.Tx, provider, login string) (string, error) {
+	const op = "webhook.repository.GetUserID"
+
+	var userID string
+	if err := tx.QueryRow(ctx, getIdentityUserIDQuery, provider, login).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return userID, nil
+}
+
+// Upsert связывает логин с пользователем. Существующая связь логина перезаписывается.
+func (r *Repository) Upsert(ctx context.Context, tx pgx.Tx, identity api.GitIdentity) error {
+	const op = "webhook.repository.Upsert"
+
+	if _, err := tx.Exec(ctx, upsertIdentityQuery, identity.Provider, identity.Login, identity.UserId); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Delete удаляет связь логина. Возвращает false, если ее не было.
+func (r *Repository) Delete(ctx context.Context, tx pgx.Tx, provider, login string) (bool, error) {
+	const op = "webhook.repository.Delete"
+
+	tag, err := tx.Exec(ctx, deleteIdentityQuery, provider, login)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListByUser возвращает все логины пользователя.
+func (r *Repository) ListByUser(ctx context.Context, tx pgx.Tx, userID string) ([]api.GitIdentity, error) {
+	const op = "webhook.repository.ListByUser"
+
+	rows, err := tx.Query(ctx, listIdentitiesByUserQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	identities := []api.GitIdentity{}
+	for rows.Next() {
+		var identity api.GitIdentity
+		if err := rows.Scan(&identity.Provider, &identity.Login, &identity.UserId); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		identities = append(identities, identity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return identities, nil
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// maxPayloadSize — предельный размер payload, который отправляет GitHub (у GitLab ограничение меньше).
+const maxPayloadSize = 25 << 20
+
+// Config задает секреты провайдеров. Вебхук провайдера с пустым секретом не регистрируется.
+type Config struct {
+	GitHubSecret string
+	GitLabToken  string
+}
+
+type Handler struct {
+	service *Service
+	cfg     Config
+	logger  *slog.Logger
+}
+
+func NewHandler(service *Service, cfg Config, logger *slog.Logger) *Handler {
+	return &Handler{
+		service: service,
+		cfg:     cfg,
+		logger:  logger,
+	}
+}
+
+func (h *Handler) log(r *http.Request) *slog.Logger {
+	return logging.FromContext(r.Context(), h.logger)
+}
+
+// Routes возвращает роутер приемников вебхуков, который монтируется в /webhooks.
+func (h *Handler) Routes() http.Handler {
+	router := chi.NewRouter()
+
+	if h.cfg.GitHubSecret != "" {
+		router.Post("/github", h.github)
+	}
+	if h.cfg.GitLabToken != "" {
+		router.Post("/gitlab", h.gitlab)
+	}
+
+	return router
+}
+
+// github принимает доставку вебхука GitHub. Подпись проверяется по сырому телу запроса.
+func (h *Handler) github(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		h.writeError(w, r, http.StatusBadRequest, api.INVALIDINPUT, "failed to read payload")
+		return
+	}
+
+	if !verifyGitHubSignature(h.cfg.GitHubSecret, body, r.Header.Get(githubSignatureHeader)) {
+		h.writeError(w, r, http.StatusUnauthorized, api.UNAUTHORIZED, "invalid X-Hub-Signature-256")
+		return
+	}
+
+	result, err := h.service.HandleGitHub(r.Context(), r.Header.Get(githubEventHeader), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, r, http.StatusOK, result)
+}
+
+// gitlab принимает доставку вебхука GitLab. GitLab не подписывает payload, а передает
+// секретный токен в заголовке X-Gitlab-Token.
+func (h *Handler) gitlab(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get(gitlabTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.cfg.GitLabToken)) != 1 {
+		h.writeError(w, r, http.StatusUnauthorized, api.UNAUTHORIZED, "invalid X-Gitlab-Token")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		h.writeError(w, r, http.StatusBadRequest, api.INVALIDINPUT, "failed to read payload")
+		return
+	}
+
+	result, err := h.service.HandleGitLab(r.Context(), r.Header.Get(gitlabEventHeader), body)
+	if err != nil {
+		h.handleError(w, r, err)
+		return
+	}
+	h.writeJson(w, r, http.StatusOK, result)
+}
+
+// handleError сообщает провайдеру, почему событие не применено. GitHub и GitLab показывают
+// ответ в истории доставок, поэтому ошибки данных возвращаются как 4xx с понятным кодом.
+func (h *Handler) handleError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, types.ErrUnknownIdentity):
+		h.writeError(w, r, http.StatusUnprocessableEntity, api.UNKNOWNIDENTITY, err.Error())
+	case errors.Is(err, types.ErrTeamRequired):
+		h.writeError(w, r, http.StatusUnprocessableEntity, api.TEAMREQUIRED, "author belongs to several teams")
+	case errors.Is(err, types.ErrPRMerged):
+		h.writeError(w, r, http.StatusConflict, api.PRMERGED, "pr is already merged")
+	case errors.Is(err, types.ErrPRClosed):
+		h.writeError(w, r, http.StatusConflict, api.PRCLOSED, "pr is closed")
+	case errors.Is(err, types.ErrNotFound):
+		h.writeError(w, r, http.StatusNotFound, api.NOTFOUND, "resource not found")
+	case errors.Is(err, types.ErrInvalidInput):
+		h.writeError(w, r, http.StatusBadRequest, api.INVALIDINPUT, err.Error())
+	default:
+		h.log(r).ErrorContext(r.Context(), "Internal Server Error", "error", err, "path", r.URL.Path)
+		utils.WriteError(w, h.log(r), http.StatusInternalServerError, err)
+	}
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, status int, code api.ErrorResponseErrorCode, message string) {
+	resp := api.ErrorResponse{}
+	resp.Error.Code = code
+	resp.Error.Message = message
+	h.writeJson(w, r, status, resp)
+}
+
+func (h *Handler) writeJson(w http.ResponseWriter, r *http.Request, status int, v any) {
+	if err := utils.WriteJson(w, status, v); err != nil {
+		h.log(r).ErrorContext(r.Context(), "failed to write webhook response", "error", err)
+	}
+}
@@ -0,0 +1,371 @@
+package webhook
+
+import (
+	"context"
+	"deplagene/avito-tech-internship/cmd/api"
+	"deplagene/avito-tech-internship/internal/logging"
+	"deplagene/avito-tech-internship/types"
+	"deplagene/avito-tech-internship/utils"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Системы хостинга кода, из которых принимаются вебхуки, и чаты, из которых приходят
+// slash-команды. Логин в чате — неизменяемый ID пользователя Slack или Mattermost.
+const (
+	ProviderGitHub     = "github"
+	ProviderGitLab     = "gitlab"
+	ProviderSlack      = "slack"
+	ProviderMattermost = "mattermost"
+)
+
+var providers = []string{ProviderGitHub, ProviderGitLab, ProviderSlack, ProviderMattermost}
+
+// lifecycleAction — действие над PR, к которому сводятся события GitHub и GitLab.
+type lifecycleAction string
+
+const (
+	actionOpen   lifecycleAction = "open"
+	actionReopen lifecycleAction = "reopen"
+	actionClose  lifecycleAction = "close"
+	actionMerge  lifecycleAction = "merge"
+	actionDraft  lifecycleAction = "draft"
+	actionReady  lifecycleAction = "ready"
+	actionIgnore lifecycleAction = ""
+)
+
+// Значения WebhookResult.Result.
+const (
+	resultCreated      = "created"
+	resultCreatedDraft = "created_draft"
+	resultDuplicate    = "duplicate"
+	resultMerged       = "merged"
+	resultClosed       = "closed"
+	resultReopened     = "reopened"
+	resultDraft        = "draft"
+	resultReady        = "ready"
+	resultIgnored      = "ignored"
+)
+
+// prEvent — событие PR/MR, уже разобранное из формата конкретного провайдера.
+type prEvent struct {
+	Provider      string
+	Event         string
+	RawAction     string
+	Action        lifecycleAction
+	PullRequestID string
+	Title         string
+	AuthorLogin   string
+	Draft         bool
+}
+
+// Service применяет события PR из GitHub/GitLab к pullrequest.Service
+// и хранит сопоставление логинов с пользователями.
+type Service struct {
+	identityRepo *Repository
+	userRepo     types.UserRepository
+	prService    types.PullRequestService
+	db           *pgxpool.Pool
+	logger       *slog.Logger
+}
+
+func NewService(
+	identityRepo *Repository,
+	userRepo types.UserRepository,
+	prService types.PullRequestService,
+	db *pgxpool.Pool,
+	logger *slog.Logger,
+) *Service {
+	return &Service{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		prService:    prService,
+		db:           db,
+		logger:       logger,
+	}
+}
+
+func (s *Service) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// LinkIdentity связывает логин в GitHub/GitLab или чате с пользователем. Логины сравниваются без учета регистра.
+func (s *Service) LinkIdentity(ctx context.Context, identity api.GitIdentity) (linked *api.GitIdentity, err error) {
+	const op = "webhook.service.LinkIdentity"
+
+	identity.Provider = strings.ToLower(strings.TrimSpace(identity.Provider))
+	identity.Login = strings.ToLower(strings.TrimSpace(identity.Login))
+	if !slices.Contains(providers, identity.Provider) {
+		return nil, fmt.Errorf("%s: provider must be one of %s: %w", op, strings.Join(providers, ", "), types.ErrInvalidInput)
+	}
+	if identity.Login == "" || identity.UserId == "" {
+		return nil, fmt.Errorf("%s: login and user_id are required: %w", op, types.ErrInvalidInput)
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else if err = tx.Commit(ctx); err != nil {
+			linked, err = nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}()
+
+	user, err := s.userRepo.GetByID(ctx, tx, identity.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, types.ErrNotFound
+	}
+
+	if err = s.identityRepo.Upsert(ctx, tx, identity); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &identity, nil
+}
+
+// UnlinkIdentity удаляет связь логина с пользователем.
+func (s *Service) UnlinkIdentity(ctx context.Context, provider, login string) (err error) {
+	const op = "webhook.service.UnlinkIdentity"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	deleted, err := s.identityRepo.Delete(ctx, tx, strings.ToLower(provider), strings.ToLower(login))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !deleted {
+		return types.ErrNotFound
+	}
+	return nil
+}
+
+// ListIdentities возвращает логины пользователя во всех системах хостинга кода.
+func (s *Service) ListIdentities(ctx context.Context, userID string) (identities []api.GitIdentity, err error) {
+	const op = "webhook.service.ListIdentities"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	user, err := s.userRepo.GetByID(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if user == nil {
+		return nil, types.ErrNotFound
+	}
+
+	identities, err = s.identityRepo.ListByUser(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return identities, nil
+}
+
+// handleEvent применяет событие к PR. Повторные доставки безопасны: создание существующего PR,
+// повторное закрытие или merge ничего не меняют. События для PR, которых сервис не видел
+// (вебхук подключен позже), создают PR, если из события понятно его состояние, иначе игнорируются.
+func (s *Service) handleEvent(ctx context.Context, event prEvent) (*api.WebhookResult, error) {
+	const op = "webhook.service.handleEvent"
+
+	result := &api.WebhookResult{
+		Event:         event.Event,
+		Action:        event.RawAction,
+		PullRequestId: event.PullRequestID,
+		Result:        resultIgnored,
+	}
+
+	var err error
+	switch event.Action {
+	case actionOpen:
+		result.Result, err = s.open(ctx, event)
+	case actionReopen:
+		result.Result = resultReopened
+		if _, err = s.prService.ReopenPullRequest(ctx, event.PullRequestID); errors.Is(err, types.ErrNotFound) {
+			result.Result, err = s.open(ctx, event)
+		}
+	case actionClose:
+		result.Result = resultClosed
+		if _, err = s.prService.ClosePullRequest(ctx, event.PullRequestID); errors.Is(err, types.ErrNotFound) {
+			result.Result, err = resultIgnored, nil
+		}
+	case actionMerge:
+		result.Result = resultMerged
+		if _, err = s.prService.MergePullRequest(ctx, event.PullRequestID); errors.Is(err, types.ErrNotFound) {
+			result.Result, err = resultIgnored, nil
+		}
+	case actionDraft, actionReady:
+		result.Result = resultReady
+		if event.Action == actionDraft {
+			result.Result = resultDraft
+		}
+		if _, err = s.prService.SetDraft(ctx, event.PullRequestID, event.Action == actionDraft); errors.Is(err, types.ErrNotFound) {
+			event.Draft = event.Action == actionDraft
+			result.Result, err = s.open(ctx, event)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.log(ctx).InfoContext(ctx, "webhook event processed",
+		"provider", event.Provider, "event", event.Event, "action", event.RawAction,
+		"pull_request_id", event.PullRequestID, "result", result.Result)
+	return result, nil
+}
+
+// open создает PR от имени пользователя, связанного с логином автора. В событии нет команды,
+// поэтому PR создается в основной команде автора: иначе автор из нескольких команд получал бы TEAM_REQUIRED.
+func (s *Service) open(ctx context.Context, event prEvent) (string, error) {
+	author, err := s.resolveAuthor(ctx, event.Provider, event.AuthorLogin)
+	if err != nil {
+		return "", err
+	}
+
+	pr := api.PullRequest{
+		PullRequestId:   event.PullRequestID,
+		PullRequestName: event.Title,
+		AuthorId:        author.UserId,
+	}
+
+	result := resultCreated
+	if event.Draft {
+		result = resultCreatedDraft
+		_, err = s.prService.CreateDraftPullRequest(ctx, pr, author.TeamName)
+	} else {
+		_, err = s.prService.CreatePullRequest(ctx, pr, author.TeamName)
+	}
+	if errors.Is(err, types.ErrAlreadyExists) {
+		return resultDuplicate, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+// resolveAuthor возвращает пользователя, связанного с логином автора PR, или ErrUnknownIdentity.
+func (s *Service) resolveAuthor(ctx context.Context, provider, login string) (author *api.User, err error) {
+	const op = "webhook.service.resolveAuthor"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	userID, err := s.identityRepo.GetUserID(ctx, tx, provider, strings.ToLower(login))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if userID == "" {
+		return nil, fmt.Errorf("%s: %s login %q: %w", op, provider, login, types.ErrUnknownIdentity)
+	}
+
+	author, err = s.userRepo.GetByID(ctx, tx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if author == nil {
+		return nil, fmt.Errorf("%s: user %s: %w", op, userID, types.ErrNotFound)
+	}
+	return author, nil
+}
+
+// ResolveIdentity возвращает user_id, связанный с логином провайдера, или ErrUnknownIdentity.
+func (s *Service) ResolveIdentity(ctx context.Context, provider, login string) (userID string, err error) {
+	const op = "webhook.service.ResolveIdentity"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction after panic", utils.Err(err))
+			}
+			panic(r)
+		} else if err != nil {
+			if err := tx.Rollback(ctx); err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to rollback transaction", utils.Err(err))
+			}
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+
+	userID, err = s.identityRepo.GetUserID(ctx, tx, provider, strings.ToLower(login))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if userID == "" {
+		return "", fmt.Errorf("%s: %s login %q: %w", op, provider, login, types.ErrUnknownIdentity)
+	}
+	return userID, nil
+}
+
+// Проверка соответствия интерфейсу во время компиляции
+var _ types.IdentityService = (*Service)(nil)
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_teams_parent_team;
+
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS teams_parent_not_self,
+    DROP COLUMN IF EXISTS review_sla_hours,
+    DROP COLUMN IF EXISTS review_strategy,
+    DROP COLUMN IF EXISTS reviewer_count,
+    DROP COLUMN IF EXISTS parent_team;
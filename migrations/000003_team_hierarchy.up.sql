@@ -0,0 +1,8 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS parent_team VARCHAR(255) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS reviewer_count SMALLINT CHECK (reviewer_count BETWEEN 0 AND 10),
+    ADD COLUMN IF NOT EXISTS review_strategy VARCHAR(32) CHECK (review_strategy IN ('random', 'least_loaded')),
+    ADD COLUMN IF NOT EXISTS review_sla_hours INTEGER CHECK (review_sla_hours > 0),
+    ADD CONSTRAINT teams_parent_not_self CHECK (parent_team IS NULL OR parent_team <> team_name);
+
+CREATE INDEX IF NOT EXISTS idx_teams_parent_team ON teams(parent_team);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_is_active;
+DROP INDEX IF EXISTS idx_users_role;
+DROP INDEX IF EXISTS idx_users_username_trgm;
+DROP INDEX IF EXISTS idx_users_username_prefix;
+
+ALTER TABLE users DROP COLUMN IF EXISTS role;
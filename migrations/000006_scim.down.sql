@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_users_username;
+DROP INDEX IF EXISTS idx_users_external_id;
+DROP INDEX IF EXISTS idx_teams_external_id;
+DROP INDEX IF EXISTS idx_teams_scim_id;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS external_id,
+    DROP COLUMN IF EXISTS scim_id;
+
+ALTER TABLE users DROP COLUMN IF EXISTS external_id;
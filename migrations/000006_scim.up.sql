@@ -0,0 +1,10 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS scim_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_scim_id ON teams (scim_id);
+CREATE INDEX IF NOT EXISTS idx_teams_external_id ON teams (external_id);
+CREATE INDEX IF NOT EXISTS idx_users_external_id ON users (external_id);
+CREATE INDEX IF NOT EXISTS idx_users_username ON users (username);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS review_events;
+
+ALTER TABLE reviewers DROP COLUMN IF EXISTS assigned_at;
@@ -0,0 +1,31 @@
+ALTER TABLE reviewers ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMPTZ;
+
+UPDATE reviewers rev
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.pull_request_id = rev.pull_request_id AND rev.assigned_at IS NULL;
+
+ALTER TABLE reviewers
+    ALTER COLUMN assigned_at SET DEFAULT NOW(),
+    ALTER COLUMN assigned_at SET NOT NULL;
+
+-- История назначений ревьюверов. ASSIGNED — назначение (при создании PR или замене),
+-- UNASSIGNED — снятие ревьювера с PR (reassign, выход из команды, деактивация).
+CREATE TABLE IF NOT EXISTS review_events (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) REFERENCES users(user_id) ON DELETE CASCADE,
+    event_type VARCHAR(32) NOT NULL,
+    team_name VARCHAR(255) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE SET NULL,
+    strategy VARCHAR(32),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_events_user_id ON review_events (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_review_events_team_name ON review_events (team_name, created_at);
+CREATE INDEX IF NOT EXISTS idx_review_events_pull_request_id ON review_events (pull_request_id);
+
+INSERT INTO review_events (pull_request_id, user_id, event_type, team_name, created_at)
+SELECT rev.pull_request_id, rev.user_id, 'ASSIGNED', pr.team_name, rev.assigned_at
+FROM reviewers rev
+JOIN pull_requests pr ON pr.pull_request_id = rev.pull_request_id;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_review_events_event_type;
+DROP INDEX IF EXISTS idx_pull_requests_team_merged_at;
+DROP INDEX IF EXISTS idx_pull_requests_team_created_at;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS initial_reviewer_count;
@@ -0,0 +1,15 @@
+-- Число ревьюверов, назначенных при создании PR. Для существующих PR берется текущее число ревьюверов.
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS initial_reviewer_count SMALLINT;
+
+UPDATE pull_requests pr
+SET initial_reviewer_count = (SELECT COUNT(*) FROM reviewers rev WHERE rev.pull_request_id = pr.pull_request_id)
+WHERE pr.initial_reviewer_count IS NULL;
+
+ALTER TABLE pull_requests
+    ALTER COLUMN initial_reviewer_count SET DEFAULT 0,
+    ALTER COLUMN initial_reviewer_count SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_team_created_at ON pull_requests (team_name, created_at);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_team_merged_at ON pull_requests (team_name, merged_at);
+-- NO_CANDIDATE в review_events: для снимаемого ревьювера (user_id) не нашлось замены.
+CREATE INDEX IF NOT EXISTS idx_review_events_event_type ON review_events (event_type, created_at);
@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS git_identities;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS is_draft;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS closed_at;
+
+-- Значение из enum удалить нельзя, поэтому тип пересоздается. Закрытые без merge PR удаляются:
+-- в старой схеме их нельзя представить.
+DELETE FROM pull_requests WHERE status = 'CLOSED';
+
+ALTER TABLE pull_requests ALTER COLUMN status DROP DEFAULT;
+ALTER TYPE pr_status RENAME TO pr_status_old;
+CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED');
+ALTER TABLE pull_requests ALTER COLUMN status TYPE pr_status USING status::text::pr_status;
+ALTER TABLE pull_requests ALTER COLUMN status SET DEFAULT 'OPEN';
+DROP TYPE pr_status_old;
@@ -0,0 +1,17 @@
+-- PR, закрытый без merge на стороне GitHub/GitLab
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'CLOSED';
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
+-- Черновикам ревьюверы не назначаются, пока PR не отмечен как готовый к ревью
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS is_draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Логины пользователей в системах хостинга кода (github, gitlab)
+CREATE TABLE IF NOT EXISTS git_identities (
+    provider VARCHAR(32) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_git_identities_user_id ON git_identities (user_id);
@@ -0,0 +1,21 @@
+-- Последний номер события каждого агрегата (PR, команды, пользователя). Строка блокируется
+-- до конца транзакции, поэтому события одного агрегата получают номера в порядке фиксации.
+CREATE TABLE IF NOT EXISTS outbox_sequences (
+    aggregate_type VARCHAR(32) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    last_sequence BIGINT NOT NULL,
+    PRIMARY KEY (aggregate_type, aggregate_id)
+);
+
+-- Доменные события для брокера сообщений. Пишутся в транзакции изменения,
+-- relay публикует их по возрастанию id и удаляет после подтверждения брокера.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL UNIQUE,
+    aggregate_type VARCHAR(32) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    sequence BIGINT NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,12 @@
+-- Настройки email-уведомлений пользователя. Без строки или с пустым email письма не отправляются.
+-- last_digest_on — дата последнего отправленного дайджеста, чтобы при нескольких экземплярах
+-- сервиса пользователь получал не больше одного дайджеста в день.
+CREATE TABLE IF NOT EXISTS notification_settings (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    email TEXT NOT NULL DEFAULT '',
+    assigned BOOLEAN NOT NULL DEFAULT TRUE,
+    reassigned BOOLEAN NOT NULL DEFAULT TRUE,
+    digest BOOLEAN NOT NULL DEFAULT TRUE,
+    last_digest_on DATE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+-- Пользователь, удаленный через SCIM DELETE. Запись остается ради ссылок из PR и истории ревью,
+-- но для SCIM ее больше нет: любые операции над ней отвечают 404 (RFC 7644, раздел 3.6).
+ALTER TABLE users ADD COLUMN IF NOT EXISTS scim_deleted_at TIMESTAMPTZ;
@@ -0,0 +1,3511 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: review/v1/review.proto
+
+// gRPC API сервиса назначения ревьюверов. Повторяет TeamService, UserService и PullRequestService
+// из REST API и работает поверх тех же сервисов, поэтому бизнес-правила и ошибки совпадают.
+//
+// Ошибки возвращаются статусами gRPC; google.rpc.ErrorInfo в деталях статуса содержит код ошибки
+// REST API (NOT_FOUND, PR_MERGED, ...) в поле reason.
+
+package reviewv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewStrategy int32
+
+const (
+	ReviewStrategy_REVIEW_STRATEGY_UNSPECIFIED  ReviewStrategy = 0
+	ReviewStrategy_REVIEW_STRATEGY_RANDOM       ReviewStrategy = 1
+	ReviewStrategy_REVIEW_STRATEGY_LEAST_LOADED ReviewStrategy = 2
+)
+
+// Enum value maps for ReviewStrategy.
+var (
+	ReviewStrategy_name = map[int32]string{
+		0: "REVIEW_STRATEGY_UNSPECIFIED",
+		1: "REVIEW_STRATEGY_RANDOM",
+		2: "REVIEW_STRATEGY_LEAST_LOADED",
+	}
+	ReviewStrategy_value = map[string]int32{
+		"REVIEW_STRATEGY_UNSPECIFIED":  0,
+		"REVIEW_STRATEGY_RANDOM":       1,
+		"REVIEW_STRATEGY_LEAST_LOADED": 2,
+	}
+)
+
+func (x ReviewStrategy) Enum() *ReviewStrategy {
+	p := new(ReviewStrategy)
+	*p = x
+	return p
+}
+
+func (x ReviewStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_review_v1_review_proto_enumTypes[0].Descriptor()
+}
+
+func (ReviewStrategy) Type() protoreflect.EnumType {
+	return &file_review_v1_review_proto_enumTypes[0]
+}
+
+func (x ReviewStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewStrategy.Descriptor instead.
+func (ReviewStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{0}
+}
+
+type UserSearchMode int32
+
+const (
+	UserSearchMode_USER_SEARCH_MODE_UNSPECIFIED UserSearchMode = 0
+	UserSearchMode_USER_SEARCH_MODE_PREFIX      UserSearchMode = 1
+	UserSearchMode_USER_SEARCH_MODE_TRIGRAM     UserSearchMode = 2
+)
+
+// Enum value maps for UserSearchMode.
+var (
+	UserSearchMode_name = map[int32]string{
+		0: "USER_SEARCH_MODE_UNSPECIFIED",
+		1: "USER_SEARCH_MODE_PREFIX",
+		2: "USER_SEARCH_MODE_TRIGRAM",
+	}
+	UserSearchMode_value = map[string]int32{
+		"USER_SEARCH_MODE_UNSPECIFIED": 0,
+		"USER_SEARCH_MODE_PREFIX":      1,
+		"USER_SEARCH_MODE_TRIGRAM":     2,
+	}
+)
+
+func (x UserSearchMode) Enum() *UserSearchMode {
+	p := new(UserSearchMode)
+	*p = x
+	return p
+}
+
+func (x UserSearchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSearchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_review_v1_review_proto_enumTypes[1].Descriptor()
+}
+
+func (UserSearchMode) Type() protoreflect.EnumType {
+	return &file_review_v1_review_proto_enumTypes[1]
+}
+
+func (x UserSearchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSearchMode.Descriptor instead.
+func (UserSearchMode) EnumDescriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{1}
+}
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+	PullRequestStatus_PULL_REQUEST_STATUS_CLOSED      PullRequestStatus = 3
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+		3: "PULL_REQUEST_STATUS_CLOSED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+		"PULL_REQUEST_STATUS_CLOSED":      3,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_review_v1_review_proto_enumTypes[2].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_review_v1_review_proto_enumTypes[2]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{2}
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_review_v1_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_review_v1_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// Собственные настройки команды; незаданные поля наследуются от родительской команды.
+type TeamSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerCount *int32                 `protobuf:"varint,1,opt,name=reviewer_count,json=reviewerCount,proto3,oneof" json:"reviewer_count,omitempty"`
+	Strategy      *ReviewStrategy        `protobuf:"varint,2,opt,name=strategy,proto3,enum=review.v1.ReviewStrategy,oneof" json:"strategy,omitempty"`
+	SlaHours      *int32                 `protobuf:"varint,3,opt,name=sla_hours,json=slaHours,proto3,oneof" json:"sla_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSettings) Reset() {
+	*x = TeamSettings{}
+	mi := &file_review_v1_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSettings) ProtoMessage() {}
+
+func (x *TeamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSettings.ProtoReflect.Descriptor instead.
+func (*TeamSettings) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TeamSettings) GetReviewerCount() int32 {
+	if x != nil && x.ReviewerCount != nil {
+		return *x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *TeamSettings) GetStrategy() ReviewStrategy {
+	if x != nil && x.Strategy != nil {
+		return *x.Strategy
+	}
+	return ReviewStrategy_REVIEW_STRATEGY_UNSPECIFIED
+}
+
+func (x *TeamSettings) GetSlaHours() int32 {
+	if x != nil && x.SlaHours != nil {
+		return *x.SlaHours
+	}
+	return 0
+}
+
+// Настройки команды после применения наследования; sla_hours = 0 — SLA не задан.
+type EffectiveTeamSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerCount int32                  `protobuf:"varint,1,opt,name=reviewer_count,json=reviewerCount,proto3" json:"reviewer_count,omitempty"`
+	Strategy      ReviewStrategy         `protobuf:"varint,2,opt,name=strategy,proto3,enum=review.v1.ReviewStrategy" json:"strategy,omitempty"`
+	SlaHours      int32                  `protobuf:"varint,3,opt,name=sla_hours,json=slaHours,proto3" json:"sla_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectiveTeamSettings) Reset() {
+	*x = EffectiveTeamSettings{}
+	mi := &file_review_v1_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectiveTeamSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveTeamSettings) ProtoMessage() {}
+
+func (x *EffectiveTeamSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveTeamSettings.ProtoReflect.Descriptor instead.
+func (*EffectiveTeamSettings) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EffectiveTeamSettings) GetReviewerCount() int32 {
+	if x != nil {
+		return x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *EffectiveTeamSettings) GetStrategy() ReviewStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ReviewStrategy_REVIEW_STRATEGY_UNSPECIFIED
+}
+
+func (x *EffectiveTeamSettings) GetSlaHours() int32 {
+	if x != nil {
+		return x.SlaHours
+	}
+	return 0
+}
+
+type TeamNode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeam        *string                `protobuf:"bytes,2,opt,name=parent_team,json=parentTeam,proto3,oneof" json:"parent_team,omitempty"`
+	Members           []*TeamMember          `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Settings          *TeamSettings          `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+	EffectiveSettings *EffectiveTeamSettings `protobuf:"bytes,5,opt,name=effective_settings,json=effectiveSettings,proto3" json:"effective_settings,omitempty"`
+	Subteams          []*TeamNode            `protobuf:"bytes,6,rep,name=subteams,proto3" json:"subteams,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TeamNode) Reset() {
+	*x = TeamNode{}
+	mi := &file_review_v1_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamNode) ProtoMessage() {}
+
+func (x *TeamNode) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamNode.ProtoReflect.Descriptor instead.
+func (*TeamNode) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TeamNode) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamNode) GetParentTeam() string {
+	if x != nil && x.ParentTeam != nil {
+		return *x.ParentTeam
+	}
+	return ""
+}
+
+func (x *TeamNode) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *TeamNode) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *TeamNode) GetEffectiveSettings() *EffectiveTeamSettings {
+	if x != nil {
+		return x.EffectiveSettings
+	}
+	return nil
+}
+
+func (x *TeamNode) GetSubteams() []*TeamNode {
+	if x != nil {
+		return x.Subteams
+	}
+	return nil
+}
+
+type RosterRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Номер строки файла; 0 — ошибка относится к файлу целиком.
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RosterRowError) Reset() {
+	*x = RosterRowError{}
+	mi := &file_review_v1_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RosterRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterRowError) ProtoMessage() {}
+
+func (x *RosterRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterRowError.ProtoReflect.Descriptor instead.
+func (*RosterRowError) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RosterRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *RosterRowError) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RosterRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{10}
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamNames     []string               `protobuf:"bytes,1,rep,name=team_names,json=teamNames,proto3" json:"team_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTeamsResponse) GetTeamNames() []string {
+	if x != nil {
+		return x.TeamNames
+	}
+	return nil
+}
+
+type AddMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberRequest) Reset() {
+	*x = AddMemberRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberRequest) ProtoMessage() {}
+
+func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddMemberRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberResponse) Reset() {
+	*x = AddMemberResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberResponse) ProtoMessage() {}
+
+func (x *AddMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddMemberResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddMemberResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type RemoveMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveMemberRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{15}
+}
+
+type RenameTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	NewTeamName   string                 `protobuf:"bytes,2,opt,name=new_team_name,json=newTeamName,proto3" json:"new_team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTeamRequest) Reset() {
+	*x = RenameTeamRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTeamRequest) ProtoMessage() {}
+
+func (x *RenameTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTeamRequest.ProtoReflect.Descriptor instead.
+func (*RenameTeamRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RenameTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RenameTeamRequest) GetNewTeamName() string {
+	if x != nil {
+		return x.NewTeamName
+	}
+	return ""
+}
+
+type RenameTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTeamResponse) Reset() {
+	*x = RenameTeamResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTeamResponse) ProtoMessage() {}
+
+func (x *RenameTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTeamResponse.ProtoReflect.Descriptor instead.
+func (*RenameTeamResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenameTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{19}
+}
+
+type GetTeamTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeRequest) Reset() {
+	*x = GetTeamTreeRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeRequest) ProtoMessage() {}
+
+func (x *GetTeamTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTeamTreeRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *TeamNode              `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeResponse) Reset() {
+	*x = GetTeamTreeResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeResponse) ProtoMessage() {}
+
+func (x *GetTeamTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTeamTreeResponse) GetTeam() *TeamNode {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type SetParentTeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Незаданное значение делает команду корнем дерева.
+	ParentTeam    *string `protobuf:"bytes,2,opt,name=parent_team,json=parentTeam,proto3,oneof" json:"parent_team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParentTeamRequest) Reset() {
+	*x = SetParentTeamRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParentTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParentTeamRequest) ProtoMessage() {}
+
+func (x *SetParentTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParentTeamRequest.ProtoReflect.Descriptor instead.
+func (*SetParentTeamRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetParentTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetParentTeamRequest) GetParentTeam() string {
+	if x != nil && x.ParentTeam != nil {
+		return *x.ParentTeam
+	}
+	return ""
+}
+
+type SetParentTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *TeamNode              `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParentTeamResponse) Reset() {
+	*x = SetParentTeamResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParentTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParentTeamResponse) ProtoMessage() {}
+
+func (x *SetParentTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParentTeamResponse.ProtoReflect.Descriptor instead.
+func (*SetParentTeamResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetParentTeamResponse) GetTeam() *TeamNode {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type UpdateTeamSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Settings      *TeamSettings          `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSettingsRequest) Reset() {
+	*x = UpdateTeamSettingsRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTeamSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateTeamSettingsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UpdateTeamSettingsRequest) GetSettings() *TeamSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTeamSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *TeamNode              `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamSettingsResponse) Reset() {
+	*x = UpdateTeamSettingsResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTeamSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateTeamSettingsResponse) GetTeam() *TeamNode {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type ImportTeamsCSVRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Csv   []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	// Только проверить файл, ничего не меняя.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTeamsCSVRequest) Reset() {
+	*x = ImportTeamsCSVRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTeamsCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTeamsCSVRequest) ProtoMessage() {}
+
+func (x *ImportTeamsCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTeamsCSVRequest.ProtoReflect.Descriptor instead.
+func (*ImportTeamsCSVRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportTeamsCSVRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportTeamsCSVRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportTeamsCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Applied       bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	Rows          int32                  `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Teams         int32                  `protobuf:"varint,4,opt,name=teams,proto3" json:"teams,omitempty"`
+	Errors        []*RosterRowError      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTeamsCSVResponse) Reset() {
+	*x = ImportTeamsCSVResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTeamsCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTeamsCSVResponse) ProtoMessage() {}
+
+func (x *ImportTeamsCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTeamsCSVResponse.ProtoReflect.Descriptor instead.
+func (*ImportTeamsCSVResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportTeamsCSVResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTeamsCSVResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ImportTeamsCSVResponse) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ImportTeamsCSVResponse) GetTeams() int32 {
+	if x != nil {
+		return x.Teams
+	}
+	return 0
+}
+
+func (x *ImportTeamsCSVResponse) GetErrors() []*RosterRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportTeamsCSVRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTeamsCSVRequest) Reset() {
+	*x = ExportTeamsCSVRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTeamsCSVRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTeamsCSVRequest) ProtoMessage() {}
+
+func (x *ExportTeamsCSVRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTeamsCSVRequest.ProtoReflect.Descriptor instead.
+func (*ExportTeamsCSVRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{28}
+}
+
+type ExportTeamsCSVResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTeamsCSVResponse) Reset() {
+	*x = ExportTeamsCSVResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTeamsCSVResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTeamsCSVResponse) ProtoMessage() {}
+
+func (x *ExportTeamsCSVResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTeamsCSVResponse.ProtoReflect.Descriptor instead.
+func (*ExportTeamsCSVResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportTeamsCSVResponse) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_review_v1_review_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UserProfile struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Основная команда пользователя.
+	TeamName      string   `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Teams         []string `protobuf:"bytes,4,rep,name=teams,proto3" json:"teams,omitempty"`
+	IsActive      bool     `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Role          string   `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	mi := &file_review_v1_review_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UserProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserProfile) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserProfile) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *UserProfile) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *UserProfile) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UserProfile) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetUserIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveRequest) Reset() {
+	*x = SetUserIsActiveRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveRequest) ProtoMessage() {}
+
+func (x *SetUserIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetUserIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveResponse) Reset() {
+	*x = SetUserIsActiveResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveResponse) ProtoMessage() {}
+
+func (x *SetUserIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetUserIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type MoveUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUserRequest) Reset() {
+	*x = MoveUserRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserRequest) ProtoMessage() {}
+
+func (x *MoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserRequest.ProtoReflect.Descriptor instead.
+func (*MoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveUserRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type MoveUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveUserResponse) Reset() {
+	*x = MoveUserResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserResponse) ProtoMessage() {}
+
+func (x *MoveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserResponse.ProtoReflect.Descriptor instead.
+func (*MoveUserResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MoveUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserProfile           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetUserResponse) GetUser() *UserProfile {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName *string                `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	IsActive *bool                  `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	Role     *string                `protobuf:"bytes,3,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	// Поиск по username.
+	Query  string         `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Search UserSearchMode `protobuf:"varint,5,opt,name=search,proto3,enum=review.v1.UserSearchMode" json:"search,omitempty"`
+	// next_cursor из предыдущей страницы.
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 0 — размер страницы по умолчанию.
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListUsersRequest) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetSearch() UserSearchMode {
+	if x != nil {
+		return x.Search
+	}
+	return UserSearchMode_USER_SEARCH_MODE_UNSPECIFIED
+}
+
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*UserProfile         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Пусто на последней странице.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=review.v1.PullRequestStatus" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=review.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_review_v1_review_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Обязательна, если автор состоит в нескольких командах.
+	TeamName      string `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Draft         bool   `protobuf:"varint,5,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ClosePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePullRequestRequest) Reset() {
+	*x = ClosePullRequestRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePullRequestRequest) ProtoMessage() {}
+
+func (x *ClosePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ClosePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ClosePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ClosePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePullRequestResponse) Reset() {
+	*x = ClosePullRequestResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePullRequestResponse) ProtoMessage() {}
+
+func (x *ClosePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*ClosePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ClosePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReopenPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenPullRequestRequest) Reset() {
+	*x = ReopenPullRequestRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenPullRequestRequest) ProtoMessage() {}
+
+func (x *ReopenPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReopenPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReopenPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReopenPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenPullRequestResponse) Reset() {
+	*x = ReopenPullRequestResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenPullRequestResponse) ProtoMessage() {}
+
+func (x *ReopenPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReopenPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ReopenPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type SetDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Draft         bool                   `protobuf:"varint,2,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDraftRequest) Reset() {
+	*x = SetDraftRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDraftRequest) ProtoMessage() {}
+
+func (x *SetDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDraftRequest.ProtoReflect.Descriptor instead.
+func (*SetDraftRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetDraftRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SetDraftRequest) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+type SetDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDraftResponse) Reset() {
+	*x = SetDraftResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDraftResponse) ProtoMessage() {}
+
+func (x *SetDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDraftResponse.ProtoReflect.Descriptor instead.
+func (*SetDraftResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetDraftResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pr    *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	// user_id нового ревьювера.
+	ReplacedBy    string `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type GetPullRequestsByReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestsByReviewerRequest) Reset() {
+	*x = GetPullRequestsByReviewerRequest{}
+	mi := &file_review_v1_review_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestsByReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestsByReviewerRequest) ProtoMessage() {}
+
+func (x *GetPullRequestsByReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestsByReviewerRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestsByReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPullRequestsByReviewerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPullRequestsByReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestsByReviewerResponse) Reset() {
+	*x = GetPullRequestsByReviewerResponse{}
+	mi := &file_review_v1_review_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestsByReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestsByReviewerResponse) ProtoMessage() {}
+
+func (x *GetPullRequestsByReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_v1_review_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestsByReviewerResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestsByReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_review_v1_review_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetPullRequestsByReviewerResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPullRequestsByReviewerResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+var File_review_v1_review_proto protoreflect.FileDescriptor
+
+const file_review_v1_review_proto_rawDesc = "" +
+	"\n" +
+	"\x16review/v1/review.proto\x12\treview.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"T\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12/\n" +
+	"\amembers\x18\x02 \x03(\v2\x15.review.v1.TeamMemberR\amembers\"\xc6\x01\n" +
+	"\fTeamSettings\x12*\n" +
+	"\x0ereviewer_count\x18\x01 \x01(\x05H\x00R\rreviewerCount\x88\x01\x01\x12:\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x19.review.v1.ReviewStrategyH\x01R\bstrategy\x88\x01\x01\x12 \n" +
+	"\tsla_hours\x18\x03 \x01(\x05H\x02R\bslaHours\x88\x01\x01B\x11\n" +
+	"\x0f_reviewer_countB\v\n" +
+	"\t_strategyB\f\n" +
+	"\n" +
+	"_sla_hours\"\x92\x01\n" +
+	"\x15EffectiveTeamSettings\x12%\n" +
+	"\x0ereviewer_count\x18\x01 \x01(\x05R\rreviewerCount\x125\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x19.review.v1.ReviewStrategyR\bstrategy\x12\x1b\n" +
+	"\tsla_hours\x18\x03 \x01(\x05R\bslaHours\"\xc5\x02\n" +
+	"\bTeamNode\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12$\n" +
+	"\vparent_team\x18\x02 \x01(\tH\x00R\n" +
+	"parentTeam\x88\x01\x01\x12/\n" +
+	"\amembers\x18\x03 \x03(\v2\x15.review.v1.TeamMemberR\amembers\x123\n" +
+	"\bsettings\x18\x04 \x01(\v2\x17.review.v1.TeamSettingsR\bsettings\x12O\n" +
+	"\x12effective_settings\x18\x05 \x01(\v2 .review.v1.EffectiveTeamSettingsR\x11effectiveSettings\x12/\n" +
+	"\bsubteams\x18\x06 \x03(\v2\x13.review.v1.TeamNodeR\bsubteamsB\x0e\n" +
+	"\f_parent_team\"W\n" +
+	"\x0eRosterRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"8\n" +
+	"\x11CreateTeamRequest\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.review.v1.TeamR\x04team\"9\n" +
+	"\x12CreateTeamResponse\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.review.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"6\n" +
+	"\x0fGetTeamResponse\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.review.v1.TeamR\x04team\"\x12\n" +
+	"\x10ListTeamsRequest\"2\n" +
+	"\x11ListTeamsResponse\x12\x1d\n" +
+	"\n" +
+	"team_names\x18\x01 \x03(\tR\tteamNames\"H\n" +
+	"\x10AddMemberRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
+	"\x11AddMemberResponse\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.review.v1.TeamR\x04team\"K\n" +
+	"\x13RemoveMemberRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse\"T\n" +
+	"\x11RenameTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\"\n" +
+	"\rnew_team_name\x18\x02 \x01(\tR\vnewTeamName\"9\n" +
+	"\x12RenameTeamResponse\x12#\n" +
+	"\x04team\x18\x01 \x01(\v2\x0f.review.v1.TeamR\x04team\"0\n" +
+	"\x11DeleteTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\x14\n" +
+	"\x12DeleteTeamResponse\"1\n" +
+	"\x12GetTeamTreeRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\">\n" +
+	"\x13GetTeamTreeResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.review.v1.TeamNodeR\x04team\"i\n" +
+	"\x14SetParentTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12$\n" +
+	"\vparent_team\x18\x02 \x01(\tH\x00R\n" +
+	"parentTeam\x88\x01\x01B\x0e\n" +
+	"\f_parent_team\"@\n" +
+	"\x15SetParentTeamResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.review.v1.TeamNodeR\x04team\"m\n" +
+	"\x19UpdateTeamSettingsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x123\n" +
+	"\bsettings\x18\x02 \x01(\v2\x17.review.v1.TeamSettingsR\bsettings\"E\n" +
+	"\x1aUpdateTeamSettingsResponse\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.review.v1.TeamNodeR\x04team\"B\n" +
+	"\x15ImportTeamsCSVRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa8\x01\n" +
+	"\x16ImportTeamsCSVResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x12\x12\n" +
+	"\x04rows\x18\x03 \x01(\x05R\x04rows\x12\x14\n" +
+	"\x05teams\x18\x04 \x01(\x05R\x05teams\x121\n" +
+	"\x06errors\x18\x05 \x03(\v2\x19.review.v1.RosterRowErrorR\x06errors\"\x17\n" +
+	"\x15ExportTeamsCSVRequest\"*\n" +
+	"\x16ExportTeamsCSVResponse\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xa6\x01\n" +
+	"\vUserProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x14\n" +
+	"\x05teams\x18\x04 \x03(\tR\x05teams\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\"N\n" +
+	"\x16SetUserIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\">\n" +
+	"\x17SetUserIsActiveResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.review.v1.UserR\x04user\"G\n" +
+	"\x0fMoveUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"7\n" +
+	"\x10MoveUserResponse\x12#\n" +
+	"\x04user\x18\x01 \x01(\v2\x0f.review.v1.UserR\x04user\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\x0fGetUserResponse\x12*\n" +
+	"\x04user\x18\x01 \x01(\v2\x16.review.v1.UserProfileR\x04user\"\x8b\x02\n" +
+	"\x10ListUsersRequest\x12 \n" +
+	"\tteam_name\x18\x01 \x01(\tH\x00R\bteamName\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x01R\bisActive\x88\x01\x01\x12\x17\n" +
+	"\x04role\x18\x03 \x01(\tH\x02R\x04role\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x121\n" +
+	"\x06search\x18\x05 \x01(\x0e2\x19.review.v1.UserSearchModeR\x06search\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"_team_nameB\f\n" +
+	"\n" +
+	"_is_activeB\a\n" +
+	"\x05_role\"b\n" +
+	"\x11ListUsersResponse\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.review.v1.UserProfileR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xd7\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.review.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xb9\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.review.v1.PullRequestStatusR\x06status\"\xbe\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12\x14\n" +
+	"\x05draft\x18\x05 \x01(\bR\x05draft\"C\n" +
+	"\x19CreatePullRequestResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"B\n" +
+	"\x18MergePullRequestResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\"A\n" +
+	"\x17ClosePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"B\n" +
+	"\x18ClosePullRequestResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\"B\n" +
+	"\x18ReopenPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"C\n" +
+	"\x19ReopenPullRequestResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\"O\n" +
+	"\x0fSetDraftRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x14\n" +
+	"\x05draft\x18\x02 \x01(\bR\x05draft\":\n" +
+	"\x10SetDraftResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"c\n" +
+	"\x18ReassignReviewerResponse\x12&\n" +
+	"\x02pr\x18\x01 \x01(\v2\x16.review.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\";\n" +
+	" GetPullRequestsByReviewerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"~\n" +
+	"!GetPullRequestsByReviewerResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12@\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1b.review.v1.PullRequestShortR\fpullRequests*o\n" +
+	"\x0eReviewStrategy\x12\x1f\n" +
+	"\x1bREVIEW_STRATEGY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REVIEW_STRATEGY_RANDOM\x10\x01\x12 \n" +
+	"\x1cREVIEW_STRATEGY_LEAST_LOADED\x10\x02*m\n" +
+	"\x0eUserSearchMode\x12 \n" +
+	"\x1cUSER_SEARCH_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17USER_SEARCH_MODE_PREFIX\x10\x01\x12\x1c\n" +
+	"\x18USER_SEARCH_MODE_TRIGRAM\x10\x02*\x96\x01\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x02\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_CLOSED\x10\x032\xc4\a\n" +
+	"\vTeamService\x12I\n" +
+	"\n" +
+	"CreateTeam\x12\x1c.review.v1.CreateTeamRequest\x1a\x1d.review.v1.CreateTeamResponse\x12@\n" +
+	"\aGetTeam\x12\x19.review.v1.GetTeamRequest\x1a\x1a.review.v1.GetTeamResponse\x12F\n" +
+	"\tListTeams\x12\x1b.review.v1.ListTeamsRequest\x1a\x1c.review.v1.ListTeamsResponse\x12F\n" +
+	"\tAddMember\x12\x1b.review.v1.AddMemberRequest\x1a\x1c.review.v1.AddMemberResponse\x12O\n" +
+	"\fRemoveMember\x12\x1e.review.v1.RemoveMemberRequest\x1a\x1f.review.v1.RemoveMemberResponse\x12I\n" +
+	"\n" +
+	"RenameTeam\x12\x1c.review.v1.RenameTeamRequest\x1a\x1d.review.v1.RenameTeamResponse\x12I\n" +
+	"\n" +
+	"DeleteTeam\x12\x1c.review.v1.DeleteTeamRequest\x1a\x1d.review.v1.DeleteTeamResponse\x12L\n" +
+	"\vGetTeamTree\x12\x1d.review.v1.GetTeamTreeRequest\x1a\x1e.review.v1.GetTeamTreeResponse\x12R\n" +
+	"\rSetParentTeam\x12\x1f.review.v1.SetParentTeamRequest\x1a .review.v1.SetParentTeamResponse\x12a\n" +
+	"\x12UpdateTeamSettings\x12$.review.v1.UpdateTeamSettingsRequest\x1a%.review.v1.UpdateTeamSettingsResponse\x12U\n" +
+	"\x0eImportTeamsCSV\x12 .review.v1.ImportTeamsCSVRequest\x1a!.review.v1.ImportTeamsCSVResponse\x12U\n" +
+	"\x0eExportTeamsCSV\x12 .review.v1.ExportTeamsCSVRequest\x1a!.review.v1.ExportTeamsCSVResponse2\xb6\x02\n" +
+	"\vUserService\x12X\n" +
+	"\x0fSetUserIsActive\x12!.review.v1.SetUserIsActiveRequest\x1a\".review.v1.SetUserIsActiveResponse\x12C\n" +
+	"\bMoveUser\x12\x1a.review.v1.MoveUserRequest\x1a\x1b.review.v1.MoveUserResponse\x12@\n" +
+	"\aGetUser\x12\x19.review.v1.GetUserRequest\x1a\x1a.review.v1.GetUserResponse\x12F\n" +
+	"\tListUsers\x12\x1b.review.v1.ListUsersRequest\x1a\x1c.review.v1.ListUsersResponse2\xa8\x05\n" +
+	"\x12PullRequestService\x12^\n" +
+	"\x11CreatePullRequest\x12#.review.v1.CreatePullRequestRequest\x1a$.review.v1.CreatePullRequestResponse\x12[\n" +
+	"\x10MergePullRequest\x12\".review.v1.MergePullRequestRequest\x1a#.review.v1.MergePullRequestResponse\x12[\n" +
+	"\x10ClosePullRequest\x12\".review.v1.ClosePullRequestRequest\x1a#.review.v1.ClosePullRequestResponse\x12^\n" +
+	"\x11ReopenPullRequest\x12#.review.v1.ReopenPullRequestRequest\x1a$.review.v1.ReopenPullRequestResponse\x12C\n" +
+	"\bSetDraft\x12\x1a.review.v1.SetDraftRequest\x1a\x1b.review.v1.SetDraftResponse\x12[\n" +
+	"\x10ReassignReviewer\x12\".review.v1.ReassignReviewerRequest\x1a#.review.v1.ReassignReviewerResponse\x12v\n" +
+	"\x19GetPullRequestsByReviewer\x12+.review.v1.GetPullRequestsByReviewerRequest\x1a,.review.v1.GetPullRequestsByReviewerResponseB:Z8deplagene/avito-tech-internship/proto/review/v1;reviewv1b\x06proto3"
+
+var (
+	file_review_v1_review_proto_rawDescOnce sync.Once
+	file_review_v1_review_proto_rawDescData []byte
+)
+
+func file_review_v1_review_proto_rawDescGZIP() []byte {
+	file_review_v1_review_proto_rawDescOnce.Do(func() {
+		file_review_v1_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_review_v1_review_proto_rawDesc), len(file_review_v1_review_proto_rawDesc)))
+	})
+	return file_review_v1_review_proto_rawDescData
+}
+
+var file_review_v1_review_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_review_v1_review_proto_goTypes = []any{
+	(ReviewStrategy)(0),                       // 0: review.v1.ReviewStrategy
+	(UserSearchMode)(0),                       // 1: review.v1.UserSearchMode
+	(PullRequestStatus)(0),                    // 2: review.v1.PullRequestStatus
+	(*TeamMember)(nil),                        // 3: review.v1.TeamMember
+	(*Team)(nil),                              // 4: review.v1.Team
+	(*TeamSettings)(nil),                      // 5: review.v1.TeamSettings
+	(*EffectiveTeamSettings)(nil),             // 6: review.v1.EffectiveTeamSettings
+	(*TeamNode)(nil),                          // 7: review.v1.TeamNode
+	(*RosterRowError)(nil),                    // 8: review.v1.RosterRowError
+	(*CreateTeamRequest)(nil),                 // 9: review.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),                // 10: review.v1.CreateTeamResponse
+	(*GetTeamRequest)(nil),                    // 11: review.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),                   // 12: review.v1.GetTeamResponse
+	(*ListTeamsRequest)(nil),                  // 13: review.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),                 // 14: review.v1.ListTeamsResponse
+	(*AddMemberRequest)(nil),                  // 15: review.v1.AddMemberRequest
+	(*AddMemberResponse)(nil),                 // 16: review.v1.AddMemberResponse
+	(*RemoveMemberRequest)(nil),               // 17: review.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),              // 18: review.v1.RemoveMemberResponse
+	(*RenameTeamRequest)(nil),                 // 19: review.v1.RenameTeamRequest
+	(*RenameTeamResponse)(nil),                // 20: review.v1.RenameTeamResponse
+	(*DeleteTeamRequest)(nil),                 // 21: review.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),                // 22: review.v1.DeleteTeamResponse
+	(*GetTeamTreeRequest)(nil),                // 23: review.v1.GetTeamTreeRequest
+	(*GetTeamTreeResponse)(nil),               // 24: review.v1.GetTeamTreeResponse
+	(*SetParentTeamRequest)(nil),              // 25: review.v1.SetParentTeamRequest
+	(*SetParentTeamResponse)(nil),             // 26: review.v1.SetParentTeamResponse
+	(*UpdateTeamSettingsRequest)(nil),         // 27: review.v1.UpdateTeamSettingsRequest
+	(*UpdateTeamSettingsResponse)(nil),        // 28: review.v1.UpdateTeamSettingsResponse
+	(*ImportTeamsCSVRequest)(nil),             // 29: review.v1.ImportTeamsCSVRequest
+	(*ImportTeamsCSVResponse)(nil),            // 30: review.v1.ImportTeamsCSVResponse
+	(*ExportTeamsCSVRequest)(nil),             // 31: review.v1.ExportTeamsCSVRequest
+	(*ExportTeamsCSVResponse)(nil),            // 32: review.v1.ExportTeamsCSVResponse
+	(*User)(nil),                              // 33: review.v1.User
+	(*UserProfile)(nil),                       // 34: review.v1.UserProfile
+	(*SetUserIsActiveRequest)(nil),            // 35: review.v1.SetUserIsActiveRequest
+	(*SetUserIsActiveResponse)(nil),           // 36: review.v1.SetUserIsActiveResponse
+	(*MoveUserRequest)(nil),                   // 37: review.v1.MoveUserRequest
+	(*MoveUserResponse)(nil),                  // 38: review.v1.MoveUserResponse
+	(*GetUserRequest)(nil),                    // 39: review.v1.GetUserRequest
+	(*GetUserResponse)(nil),                   // 40: review.v1.GetUserResponse
+	(*ListUsersRequest)(nil),                  // 41: review.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                 // 42: review.v1.ListUsersResponse
+	(*PullRequest)(nil),                       // 43: review.v1.PullRequest
+	(*PullRequestShort)(nil),                  // 44: review.v1.PullRequestShort
+	(*CreatePullRequestRequest)(nil),          // 45: review.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),         // 46: review.v1.CreatePullRequestResponse
+	(*MergePullRequestRequest)(nil),           // 47: review.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),          // 48: review.v1.MergePullRequestResponse
+	(*ClosePullRequestRequest)(nil),           // 49: review.v1.ClosePullRequestRequest
+	(*ClosePullRequestResponse)(nil),          // 50: review.v1.ClosePullRequestResponse
+	(*ReopenPullRequestRequest)(nil),          // 51: review.v1.ReopenPullRequestRequest
+	(*ReopenPullRequestResponse)(nil),         // 52: review.v1.ReopenPullRequestResponse
+	(*SetDraftRequest)(nil),                   // 53: review.v1.SetDraftRequest
+	(*SetDraftResponse)(nil),                  // 54: review.v1.SetDraftResponse
+	(*ReassignReviewerRequest)(nil),           // 55: review.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),          // 56: review.v1.ReassignReviewerResponse
+	(*GetPullRequestsByReviewerRequest)(nil),  // 57: review.v1.GetPullRequestsByReviewerRequest
+	(*GetPullRequestsByReviewerResponse)(nil), // 58: review.v1.GetPullRequestsByReviewerResponse
+	(*timestamppb.Timestamp)(nil),             // 59: google.protobuf.Timestamp
+}
+var file_review_v1_review_proto_depIdxs = []int32{
+	3,  // 0: review.v1.Team.members:type_name -> review.v1.TeamMember
+	0,  // 1: review.v1.TeamSettings.strategy:type_name -> review.v1.ReviewStrategy
+	0,  // 2: review.v1.EffectiveTeamSettings.strategy:type_name -> review.v1.ReviewStrategy
+	3,  // 3: review.v1.TeamNode.members:type_name -> review.v1.TeamMember
+	5,  // 4: review.v1.TeamNode.settings:type_name -> review.v1.TeamSettings
+	6,  // 5: review.v1.TeamNode.effective_settings:type_name -> review.v1.EffectiveTeamSettings
+	7,  // 6: review.v1.TeamNode.subteams:type_name -> review.v1.TeamNode
+	4,  // 7: review.v1.CreateTeamRequest.team:type_name -> review.v1.Team
+	4,  // 8: review.v1.CreateTeamResponse.team:type_name -> review.v1.Team
+	4,  // 9: review.v1.GetTeamResponse.team:type_name -> review.v1.Team
+	4,  // 10: review.v1.AddMemberResponse.team:type_name -> review.v1.Team
+	4,  // 11: review.v1.RenameTeamResponse.team:type_name -> review.v1.Team
+	7,  // 12: review.v1.GetTeamTreeResponse.team:type_name -> review.v1.TeamNode
+	7,  // 13: review.v1.SetParentTeamResponse.team:type_name -> review.v1.TeamNode
+	5,  // 14: review.v1.UpdateTeamSettingsRequest.settings:type_name -> review.v1.TeamSettings
+	7,  // 15: review.v1.UpdateTeamSettingsResponse.team:type_name -> review.v1.TeamNode
+	8,  // 16: review.v1.ImportTeamsCSVResponse.errors:type_name -> review.v1.RosterRowError
+	33, // 17: review.v1.SetUserIsActiveResponse.user:type_name -> review.v1.User
+	33, // 18: review.v1.MoveUserResponse.user:type_name -> review.v1.User
+	34, // 19: review.v1.GetUserResponse.user:type_name -> review.v1.UserProfile
+	1,  // 20: review.v1.ListUsersRequest.search:type_name -> review.v1.UserSearchMode
+	34, // 21: review.v1.ListUsersResponse.users:type_name -> review.v1.UserProfile
+	2,  // 22: review.v1.PullRequest.status:type_name -> review.v1.PullRequestStatus
+	59, // 23: review.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	59, // 24: review.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	2,  // 25: review.v1.PullRequestShort.status:type_name -> review.v1.PullRequestStatus
+	43, // 26: review.v1.CreatePullRequestResponse.pr:type_name -> review.v1.PullRequest
+	43, // 27: review.v1.MergePullRequestResponse.pr:type_name -> review.v1.PullRequest
+	43, // 28: review.v1.ClosePullRequestResponse.pr:type_name -> review.v1.PullRequest
+	43, // 29: review.v1.ReopenPullRequestResponse.pr:type_name -> review.v1.PullRequest
+	43, // 30: review.v1.SetDraftResponse.pr:type_name -> review.v1.PullRequest
+	43, // 31: review.v1.ReassignReviewerResponse.pr:type_name -> review.v1.PullRequest
+	44, // 32: review.v1.GetPullRequestsByReviewerResponse.pull_requests:type_name -> review.v1.PullRequestShort
+	9,  // 33: review.v1.TeamService.CreateTeam:input_type -> review.v1.CreateTeamRequest
+	11, // 34: review.v1.TeamService.GetTeam:input_type -> review.v1.GetTeamRequest
+	13, // 35: review.v1.TeamService.ListTeams:input_type -> review.v1.ListTeamsRequest
+	15, // 36: review.v1.TeamService.AddMember:input_type -> review.v1.AddMemberRequest
+	17, // 37: review.v1.TeamService.RemoveMember:input_type -> review.v1.RemoveMemberRequest
+	19, // 38: review.v1.TeamService.RenameTeam:input_type -> review.v1.RenameTeamRequest
+	21, // 39: review.v1.TeamService.DeleteTeam:input_type -> review.v1.DeleteTeamRequest
+	23, // 40: review.v1.TeamService.GetTeamTree:input_type -> review.v1.GetTeamTreeRequest
+	25, // 41: review.v1.TeamService.SetParentTeam:input_type -> review.v1.SetParentTeamRequest
+	27, // 42: review.v1.TeamService.UpdateTeamSettings:input_type -> review.v1.UpdateTeamSettingsRequest
+	29, // 43: review.v1.TeamService.ImportTeamsCSV:input_type -> review.v1.ImportTeamsCSVRequest
+	31, // 44: review.v1.TeamService.ExportTeamsCSV:input_type -> review.v1.ExportTeamsCSVRequest
+	35, // 45: review.v1.UserService.SetUserIsActive:input_type -> review.v1.SetUserIsActiveRequest
+	37, // 46: review.v1.UserService.MoveUser:input_type -> review.v1.MoveUserRequest
+	39, // 47: review.v1.UserService.GetUser:input_type -> review.v1.GetUserRequest
+	41, // 48: review.v1.UserService.ListUsers:input_type -> review.v1.ListUsersRequest
+	45, // 49: review.v1.PullRequestService.CreatePullRequest:input_type -> review.v1.CreatePullRequestRequest
+	47, // 50: review.v1.PullRequestService.MergePullRequest:input_type -> review.v1.MergePullRequestRequest
+	49, // 51: review.v1.PullRequestService.ClosePullRequest:input_type -> review.v1.ClosePullRequestRequest
+	51, // 52: review.v1.PullRequestService.ReopenPullRequest:input_type -> review.v1.ReopenPullRequestRequest
+	53, // 53: review.v1.PullRequestService.SetDraft:input_type -> review.v1.SetDraftRequest
+	55, // 54: review.v1.PullRequestService.ReassignReviewer:input_type -> review.v1.ReassignReviewerRequest
+	57, // 55: review.v1.PullRequestService.GetPullRequestsByReviewer:input_type -> review.v1.GetPullRequestsByReviewerRequest
+	10, // 56: review.v1.TeamService.CreateTeam:output_type -> review.v1.CreateTeamResponse
+	12, // 57: review.v1.TeamService.GetTeam:output_type -> review.v1.GetTeamResponse
+	14, // 58: review.v1.TeamService.ListTeams:output_type -> review.v1.ListTeamsResponse
+	16, // 59: review.v1.TeamService.AddMember:output_type -> review.v1.AddMemberResponse
+	18, // 60: review.v1.TeamService.RemoveMember:output_type -> review.v1.RemoveMemberResponse
+	20, // 61: review.v1.TeamService.RenameTeam:output_type -> review.v1.RenameTeamResponse
+	22, // 62: review.v1.TeamService.DeleteTeam:output_type -> review.v1.DeleteTeamResponse
+	24, // 63: review.v1.TeamService.GetTeamTree:output_type -> review.v1.GetTeamTreeResponse
+	26, // 64: review.v1.TeamService.SetParentTeam:output_type -> review.v1.SetParentTeamResponse
+	28, // 65: review.v1.TeamService.UpdateTeamSettings:output_type -> review.v1.UpdateTeamSettingsResponse
+	30, // 66: review.v1.TeamService.ImportTeamsCSV:output_type -> review.v1.ImportTeamsCSVResponse
+	32, // 67: review.v1.TeamService.ExportTeamsCSV:output_type -> review.v1.ExportTeamsCSVResponse
+	36, // 68: review.v1.UserService.SetUserIsActive:output_type -> review.v1.SetUserIsActiveResponse
+	38, // 69: review.v1.UserService.MoveUser:output_type -> review.v1.MoveUserResponse
+	40, // 70: review.v1.UserService.GetUser:output_type -> review.v1.GetUserResponse
+	42, // 71: review.v1.UserService.ListUsers:output_type -> review.v1.ListUsersResponse
+	46, // 72: review.v1.PullRequestService.CreatePullRequest:output_type -> review.v1.CreatePullRequestResponse
+	48, // 73: review.v1.PullRequestService.MergePullRequest:output_type -> review.v1.MergePullRequestResponse
+	50, // 74: review.v1.PullRequestService.ClosePullRequest:output_type -> review.v1.ClosePullRequestResponse
+	52, // 75: review.v1.PullRequestService.ReopenPullRequest:output_type -> review.v1.ReopenPullRequestResponse
+	54, // 76: review.v1.PullRequestService.SetDraft:output_type -> review.v1.SetDraftResponse
+	56, // 77: review.v1.PullRequestService.ReassignReviewer:output_type -> review.v1.ReassignReviewerResponse
+	58, // 78: review.v1.PullRequestService.GetPullRequestsByReviewer:output_type -> review.v1.GetPullRequestsByReviewerResponse
+	56, // [56:79] is the sub-list for method output_type
+	33, // [33:56] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_review_v1_review_proto_init() }
+func file_review_v1_review_proto_init() {
+	if File_review_v1_review_proto != nil {
+		return
+	}
+	file_review_v1_review_proto_msgTypes[2].OneofWrappers = []any{}
+	file_review_v1_review_proto_msgTypes[4].OneofWrappers = []any{}
+	file_review_v1_review_proto_msgTypes[22].OneofWrappers = []any{}
+	file_review_v1_review_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_v1_review_proto_rawDesc), len(file_review_v1_review_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   56,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_review_v1_review_proto_goTypes,
+		DependencyIndexes: file_review_v1_review_proto_depIdxs,
+		EnumInfos:         file_review_v1_review_proto_enumTypes,
+		MessageInfos:      file_review_v1_review_proto_msgTypes,
+	}.Build()
+	File_review_v1_review_proto = out.File
+	file_review_v1_review_proto_goTypes = nil
+	file_review_v1_review_proto_depIdxs = nil
+}
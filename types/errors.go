@@ -6,6 +6,13 @@ var (
 	ErrNotFound      = errors.New("resource not found")
 	ErrAlreadyExists = errors.New("resource already exists")
 	ErrPRMerged      = errors.New("pr is already merged")
+	ErrPRClosed      = errors.New("pr is closed")
 	ErrNotAssigned   = errors.New("reviewer is not assigned to this pr")
 	ErrNoCandidate   = errors.New("no active replacement candidate in team")
+
+	ErrTeamHasOpenReviews = errors.New("team pull requests still have open reviews")
+	ErrUserInOtherTeam    = errors.New("user already belongs to another team")
+	ErrInvalidInput       = errors.New("invalid input")
+	ErrTeamRequired       = errors.New("author belongs to several teams, team_name is required")
+	ErrUnknownIdentity    = errors.New("login is not linked to a user")
 )
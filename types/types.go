@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"deplagene/avito-tech-internship/cmd/api"
+	"io"
 
 	"github.com/jackc/pgx/v5"
 )
@@ -11,42 +12,150 @@ import (
 type TeamRepository interface {
 	Create(ctx context.Context, tx pgx.Tx, team api.Team) error
 	GetByName(ctx context.Context, tx pgx.Tx, name string) (*api.Team, error)
+	ListNames(ctx context.Context, tx pgx.Tx) ([]string, error)
+	ListRoster(ctx context.Context, tx pgx.Tx) ([]api.RosterRow, error)
+	Exists(ctx context.Context, tx pgx.Tx, name string) (bool, error)
+	Rename(ctx context.Context, tx pgx.Tx, oldName, newName string) error
+	Delete(ctx context.Context, tx pgx.Tx, name string) ([]string, error)
+	CountOpenReviews(ctx context.Context, tx pgx.Tx, name string) (int, error)
+	GetNode(ctx context.Context, tx pgx.Tx, name string) (*api.TeamNode, error)
+	GetSubtree(ctx context.Context, tx pgx.Tx, name string) ([]api.TeamNode, error)
+	GetAncestors(ctx context.Context, tx pgx.Tx, name string) ([]string, error)
+	GetEffectiveSettings(ctx context.Context, tx pgx.Tx, name string) (api.EffectiveTeamSettings, error)
+	SetParent(ctx context.Context, tx pgx.Tx, name string, parent *string) error
+	SetSettings(ctx context.Context, tx pgx.Tx, name string, settings api.TeamSettings) error
 }
 
 // UserRepository определяет методы для работы с пользователями.
 type UserRepository interface {
 	Upsert(ctx context.Context, tx pgx.Tx, user api.TeamMember, teamName string) error
+	AddMembership(ctx context.Context, tx pgx.Tx, userID, teamName string) error
 	GetByID(ctx context.Context, tx pgx.Tx, id string) (*api.User, error)
 	SetIsActive(ctx context.Context, tx pgx.Tx, id string, isActive bool) error
 	GetActiveUsersByTeam(ctx context.Context, tx pgx.Tx, teamName string, excludeUserID string, limit int) ([]api.User, error)
-	GetTeamByUserID(ctx context.Context, tx pgx.Tx, userID string) (string, error)
+	GetLeastLoadedActiveUsersByTeam(ctx context.Context, tx pgx.Tx, teamName string, excludeUserID string, limit int) ([]api.User, error)
+	GetTeamsByUserID(ctx context.Context, tx pgx.Tx, userID string) ([]string, error)
+	SetTeam(ctx context.Context, tx pgx.Tx, userID, teamName string) error
+	RemoveFromTeam(ctx context.Context, tx pgx.Tx, userID, teamName string) (bool, error)
+	GetProfile(ctx context.Context, tx pgx.Tx, id string) (*api.UserProfile, error)
+	List(ctx context.Context, tx pgx.Tx, params api.GetUsersListParams, afterID string, limit int) ([]api.UserProfile, error)
 }
 
 // PullRequestRepository определяет методы для работы с Pull Request'ами.
 type PullRequestRepository interface {
-	Create(ctx context.Context, tx pgx.Tx, pr api.PullRequest) error
+	Create(ctx context.Context, tx pgx.Tx, pr api.PullRequest, teamName string) error
 	GetByID(ctx context.Context, tx pgx.Tx, id string) (*api.PullRequest, error)
+	GetTeamByID(ctx context.Context, tx pgx.Tx, id string) (string, error)
+	LockByID(ctx context.Context, tx pgx.Tx, id string) error
 	Merge(ctx context.Context, tx pgx.Tx, id string) error
+	Close(ctx context.Context, tx pgx.Tx, id string) error
+	Reopen(ctx context.Context, tx pgx.Tx, id string) error
+	SetDraft(ctx context.Context, tx pgx.Tx, id string, draft bool) (bool, error)
 	AddReviewer(ctx context.Context, tx pgx.Tx, prID, userID string) error
 	RemoveReviewer(ctx context.Context, tx pgx.Tx, prID, userID string) error
+	AddEvent(ctx context.Context, tx pgx.Tx, event api.ReviewEvent) error
 	GetByReviewer(ctx context.Context, tx pgx.Tx, userID string) ([]api.PullRequestShort, error)
+	GetOpenIDsByReviewer(ctx context.Context, tx pgx.Tx, userID, teamName string) ([]string, error)
+}
+
+// StatsRepository определяет аналитические запросы по ревью.
+type StatsRepository interface {
+	GetReviewerStats(ctx context.Context, tx pgx.Tx, params api.GetStatsReviewersParams) ([]api.ReviewerStats, error)
+	GetTeamStats(ctx context.Context, tx pgx.Tx, params api.GetStatsTeamsParams) ([]api.TeamStats, error)
+	GetFairness(ctx context.Context, tx pgx.Tx, params api.GetStatsFairnessParams) ([]api.TeamFairness, error)
 }
 
 // TeamService определяет методы бизнес-логики для работы с командами.
 type TeamService interface {
 	CreateTeam(ctx context.Context, team api.Team) (*api.Team, error)
 	GetTeam(ctx context.Context, name string) (*api.Team, error)
+	ListTeams(ctx context.Context) ([]string, error)
+	RemoveMember(ctx context.Context, teamName, userID string) error
+	RenameTeam(ctx context.Context, oldName, newName string) (*api.Team, error)
+	DeleteTeam(ctx context.Context, name string) error
+	AddMember(ctx context.Context, teamName, userID string) (*api.Team, error)
+	GetTeamTree(ctx context.Context, name string) (*api.TeamNode, error)
+	SetParentTeam(ctx context.Context, name string, parent *string) (*api.TeamNode, error)
+	UpdateTeamSettings(ctx context.Context, name string, settings api.TeamSettings) (*api.TeamNode, error)
+	ImportTeamsCSV(ctx context.Context, r io.Reader, dryRun bool) (*api.TeamImportResult, error)
+	ExportTeamsCSV(ctx context.Context) ([]byte, error)
 }
 
 // UserService определяет методы бизнес-логики для работы с пользователями.
 type UserService interface {
 	SetUserIsActive(ctx context.Context, userID string, isActive bool) (*api.User, error)
+	MoveUser(ctx context.Context, userID, teamName string) (*api.User, error)
+	GetUser(ctx context.Context, userID string) (*api.UserProfile, error)
+	ListUsers(ctx context.Context, params api.GetUsersListParams) (*api.UserPage, error)
+}
+
+// TeamTxService — операции над командами в рамках уже открытой транзакции.
+// Позволяет выполнить несколько изменений атомарно, например при сверке состава команд.
+type TeamTxService interface {
+	CreateTeamTx(ctx context.Context, tx pgx.Tx, team api.Team) (*api.Team, error)
+	AddMemberTx(ctx context.Context, tx pgx.Tx, teamName, userID string) (*api.Team, error)
+	RemoveMemberTx(ctx context.Context, tx pgx.Tx, teamName, userID string) error
+	DeleteTeamTx(ctx context.Context, tx pgx.Tx, name string) error
+}
+
+// UserTxService — операции над пользователями в рамках уже открытой транзакции.
+type UserTxService interface {
+	SetUserIsActiveTx(ctx context.Context, tx pgx.Tx, userID string, isActive bool) (*api.User, error)
+	MoveUserTx(ctx context.Context, tx pgx.Tx, userID, teamName string) (*api.User, error)
+}
+
+// ReviewReassigner переназначает открытые ревью пользователя в рамках уже открытой транзакции.
+// Используется другими сервисами, когда пользователь покидает команду.
+// Пустой teamName означает открытые ревью во всех командах.
+type ReviewReassigner interface {
+	ReassignOpenReviews(ctx context.Context, tx pgx.Tx, userID, teamName string) error
 }
 
 // PullRequestService определяет методы бизнес-логики для работы с Pull Request'ами.
 type PullRequestService interface {
-	CreatePullRequest(ctx context.Context, pr api.PullRequest) (*api.PullRequest, error)
+	CreatePullRequest(ctx context.Context, pr api.PullRequest, teamName string) (*api.PullRequest, error)
+	CreateDraftPullRequest(ctx context.Context, pr api.PullRequest, teamName string) (*api.PullRequest, error)
 	MergePullRequest(ctx context.Context, prID string) (*api.PullRequest, error)
+	ClosePullRequest(ctx context.Context, prID string) (*api.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, prID string) (*api.PullRequest, error)
+	SetDraft(ctx context.Context, prID string, draft bool) (*api.PullRequest, error)
 	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*api.PullRequest, string, error)
 	GetPullRequestsByReviewer(ctx context.Context, userID string) ([]api.PullRequestShort, error)
 }
+
+// StatsService определяет методы построения отчетов по ревью.
+type StatsService interface {
+	GetReviewerStats(ctx context.Context, params api.GetStatsReviewersParams) (*api.ReviewerStatsReport, error)
+	GetTeamStats(ctx context.Context, params api.GetStatsTeamsParams) (*api.TeamStatsReport, error)
+	GetTeamStatsCSV(ctx context.Context, params api.GetStatsTeamsParams) ([]byte, error)
+	GetFairness(ctx context.Context, params api.GetStatsFairnessParams) (*api.FairnessReport, error)
+}
+
+// IdentityService управляет сопоставлением логинов GitHub/GitLab и чатов с пользователями сервиса.
+type IdentityService interface {
+	LinkIdentity(ctx context.Context, identity api.GitIdentity) (*api.GitIdentity, error)
+	UnlinkIdentity(ctx context.Context, provider, login string) error
+	ListIdentities(ctx context.Context, userID string) ([]api.GitIdentity, error)
+	ResolveIdentity(ctx context.Context, provider, login string) (string, error)
+}
+
+// EventRecorder записывает событие о PR в рамках транзакции, которая это событие порождает.
+// Событие фиксируется вместе с изменением или не фиксируется вовсе, если транзакция откатится.
+type EventRecorder interface {
+	Record(ctx context.Context, tx pgx.Tx, event api.DomainEvent) error
+}
+
+// SubscriptionService управляет подписками команд на исходящие вебхуки и недоставленными событиями.
+type SubscriptionService interface {
+	CreateSubscription(ctx context.Context, subscription api.WebhookSubscription) (*api.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, teamName string) ([]api.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID string) error
+	ListDeadLetters(ctx context.Context, subscriptionID string) ([]api.DeadLetter, error)
+	RedeliverDeadLetter(ctx context.Context, deadLetterID int64) error
+}
+
+// NotificationSettingsService управляет настройками email-уведомлений пользователей.
+type NotificationSettingsService interface {
+	GetNotificationSettings(ctx context.Context, userID string) (*api.NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, update api.PostUsersNotificationSettingsJSONBody) (*api.NotificationSettings, error)
+}
@@ -28,7 +28,7 @@ func NewLogger(env string) *slog.Logger {
 		})
 	}
 
-	return slog.New(handler)
+	return slog.New(traceHandler{handler})
 }
 
 func Err(err error) slog.Attr {